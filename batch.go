@@ -0,0 +1,49 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import "unicode/utf8"
+
+// Syllables and jamo, in any form, are all three bytes long in UTF-8, so
+// they can be replaced in place.
+
+// CompatJamoBytes converts conjoining and halfwidth jamo in b to
+// compatibility jamo in place and returns b. Other bytes, including
+// invalid UTF-8, are left untouched. It does not allocate.
+func CompatJamoBytes(b []byte) []byte {
+	for i := 0; i < len(b); {
+		if b[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, n := utf8.DecodeRune(b[i:])
+		if n == 3 {
+			if c := CompatJamo(r); c != 0 && c != r {
+				utf8.EncodeRune(b[i:], c)
+			}
+		}
+		i += n
+	}
+	return b
+}
+
+// ChosungBytes replaces Hangul syllables in b with their initial
+// consonants(초성) in compatibility jamo in place and returns b; "광화문"
+// to "ㄱㅎㅁ". Other bytes are left untouched. It does not allocate.
+// See Chosung.
+func ChosungBytes(b []byte) []byte {
+	for i := 0; i < len(b); {
+		if b[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, n := utf8.DecodeRune(b[i:])
+		if isSyllable(r) {
+			utf8.EncodeRune(b[i:], compatLeads[(r-0xAC00)/(21*28)])
+		}
+		i += n
+	}
+	return b
+}
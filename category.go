@@ -0,0 +1,221 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import "unicode"
+
+// Category is a class of Hangul characters
+type Category int
+
+// Categories of Hangul characters
+const (
+	NotHangul           Category = iota
+	PrecomposedSyllable          // 가-힣; U+AC00-D7A3
+	ConjoiningLead               // modern lead consonants; U+1100-1112
+	ConjoiningMedial             // modern medial vowels; U+1161-1175
+	ConjoiningTail               // modern tail consonants; U+11A8-11C2
+	CompatibilityJamo            // modern compatibility jamo; U+3131-3163
+	ArchaicJamo                  // archaic conjoining or compatibility jamo
+	HalfwidthJamo                // halfwidth compatibility jamo; U+FFA1-FFDC
+	EnclosedHangul               // parenthesized or circled Hangul
+	HangulFiller                 // fillers; U+115F, U+1160, U+3164, U+FFA0
+)
+
+var categoryNames = []string{
+	"NotHangul",
+	"PrecomposedSyllable",
+	"ConjoiningLead",
+	"ConjoiningMedial",
+	"ConjoiningTail",
+	"CompatibilityJamo",
+	"ArchaicJamo",
+	"HalfwidthJamo",
+	"EnclosedHangul",
+	"HangulFiller",
+}
+
+// String returns name of the category
+func (c Category) String() string {
+	if c < 0 || int(c) >= len(categoryNames) {
+		return "Category(?)"
+	}
+	return categoryNames[c]
+}
+
+// Classify returns category of r.
+func Classify(r rune) Category {
+	switch {
+	case r < leadBase:
+		return NotHangul
+	case r <= LeadH:
+		return ConjoiningLead
+	case r < leadFiller:
+		return ArchaicJamo
+	case r <= medialFiller:
+		return HangulFiller
+	case r <= MedialI:
+		return ConjoiningMedial
+	case r <= oldMedialEnd:
+		return ArchaicJamo
+	case r <= TailH:
+		return ConjoiningTail
+	case r <= oldTailEnd:
+		return ArchaicJamo
+	case r < compatBase:
+		return NotHangul
+	case r <= I:
+		return CompatibilityJamo
+	case r == compatFiller:
+		return HangulFiller
+	case r <= compatEnd:
+		return ArchaicJamo
+	case r < 0x3200:
+		return NotHangul
+	case r <= 0x321E:
+		return EnclosedHangul // parenthesized; ㈀-㈞
+	case r < 0x3260:
+		return NotHangul
+	case r <= 0x327E:
+		return EnclosedHangul // circled; ㉠-㉾
+	case r < extALeadBase:
+		return NotHangul
+	case r <= extALeadEnd:
+		return ArchaicJamo // Hangul Jamo Extended-A
+	case r < 0xAC00:
+		return NotHangul
+	case r <= 0xD7A3:
+		return PrecomposedSyllable
+	case r < extBMedialBase:
+		return NotHangul
+	case r <= extBMedialEnd, extBTailBase <= r && r <= extBTailEnd:
+		return ArchaicJamo // Hangul Jamo Extended-B
+	case r == 0xFFA0:
+		return HangulFiller
+	case 0xFFA1 <= r && r <= 0xFFBE,
+		0xFFC2 <= r && r <= 0xFFC7,
+		0xFFCA <= r && r <= 0xFFCF,
+		0xFFD2 <= r && r <= 0xFFD7,
+		0xFFDA <= r && r <= 0xFFDC:
+		return HalfwidthJamo
+	}
+	return NotHangul
+}
+
+// Range tables of each category, to be used with unicode.Is or
+// unicode.In; strings.FieldsFunc(s, func(r rune) bool {
+// return !unicode.Is(hangul.HangulTable, r) }).
+var (
+	PrecomposedSyllableTable = &unicode.RangeTable{
+		R16: []unicode.Range16{{0xAC00, 0xD7A3, 1}},
+	}
+	ConjoiningLeadTable = &unicode.RangeTable{
+		R16: []unicode.Range16{{0x1100, 0x1112, 1}},
+	}
+	ConjoiningMedialTable = &unicode.RangeTable{
+		R16: []unicode.Range16{{0x1161, 0x1175, 1}},
+	}
+	ConjoiningTailTable = &unicode.RangeTable{
+		R16: []unicode.Range16{{0x11A8, 0x11C2, 1}},
+	}
+	CompatibilityJamoTable = &unicode.RangeTable{
+		R16: []unicode.Range16{{0x3131, 0x3163, 1}},
+	}
+	ArchaicJamoTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{LeadH + 1, leadFiller - 1, 1},
+			{MedialI + 1, oldMedialEnd, 1},
+			{TailH + 1, oldTailEnd, 1},
+			{compatFiller + 1, compatEnd, 1},
+			{extALeadBase, extALeadEnd, 1},
+			{extBMedialBase, extBMedialEnd, 1},
+			{extBTailBase, extBTailEnd, 1},
+		},
+	}
+	HalfwidthJamoTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0xFFA1, 0xFFBE, 1},
+			{0xFFC2, 0xFFC7, 1},
+			{0xFFCA, 0xFFCF, 1},
+			{0xFFD2, 0xFFD7, 1},
+			{0xFFDA, 0xFFDC, 1},
+		},
+	}
+	EnclosedHangulTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x3200, 0x321E, 1},
+			{0x3260, 0x327E, 1},
+		},
+	}
+	HangulFillerTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x115F, 0x1160, 1},
+			{0x3164, 0x3164, 1},
+			{0xFFA0, 0xFFA0, 1},
+		},
+	}
+
+	// HangulTable is the union of all categories
+	HangulTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x1100, 0x11FF, 1},
+			{0x3131, 0x318E, 1},
+			{0x3200, 0x321E, 1},
+			{0x3260, 0x327E, 1},
+			{0xA960, 0xA97C, 1},
+			{0xAC00, 0xD7A3, 1},
+			{0xD7B0, 0xD7C6, 1},
+			{0xD7CB, 0xD7FB, 1},
+			{0xFFA0, 0xFFBE, 1},
+			{0xFFC2, 0xFFC7, 1},
+			{0xFFCA, 0xFFCF, 1},
+			{0xFFD2, 0xFFD7, 1},
+			{0xFFDA, 0xFFDC, 1},
+		},
+	}
+)
+
+// Archaic conjoining jamo of each position; parts of ArchaicJamoTable
+var (
+	archaicLeadTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{LeadH + 1, leadFiller - 1, 1},
+			{extALeadBase, extALeadEnd, 1},
+		},
+	}
+	archaicMedialTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{MedialI + 1, oldMedialEnd, 1},
+			{extBMedialBase, extBMedialEnd, 1},
+		},
+	}
+	archaicTailTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{TailH + 1, oldTailEnd, 1},
+			{extBTailBase, extBTailEnd, 1},
+		},
+	}
+)
+
+var categoryTables = []*unicode.RangeTable{
+	nil,
+	PrecomposedSyllableTable,
+	ConjoiningLeadTable,
+	ConjoiningMedialTable,
+	ConjoiningTailTable,
+	CompatibilityJamoTable,
+	ArchaicJamoTable,
+	HalfwidthJamoTable,
+	EnclosedHangulTable,
+	HangulFillerTable,
+}
+
+// RangeTable returns range table of the category. It returns nil for
+// NotHangul.
+func (c Category) RangeTable() *unicode.RangeTable {
+	if c < 0 || int(c) >= len(categoryTables) {
+		return nil
+	}
+	return categoryTables[c]
+}
@@ -0,0 +1,150 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Chosung returns initial consonants(초성) of s in compatibility jamo;
+// "광화문" to "ㄱㅎㅁ". Characters other than Hangul syllables are left
+// untouched.
+func Chosung(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if isSyllable(r) {
+			l, _, _ := Split(r)
+			r = CompatJamo(l)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// matchChosung reports whether target rune t matches query rune q.
+// A bare initial consonant in query matches syllables start with it.
+func matchChosung(t, q rune) bool {
+	if t == q {
+		return true
+	}
+	if Lead(q) == 0 || !isSyllable(t) {
+		return false
+	}
+	l, _, _ := Split(t)
+	return Lead(q) == l
+}
+
+// hasChosungPrefix reports whether s starts with query and returns byte
+// length of the matched prefix.
+func hasChosungPrefix(s, query string) (int, bool) {
+	n := 0
+	for _, q := range query {
+		t, size := utf8.DecodeRuneInString(s[n:])
+		if size == 0 || !matchChosung(t, q) {
+			return 0, false
+		}
+		n += size
+	}
+	return n, true
+}
+
+// HasChosungPrefix reports whether s begins with query. Query can have
+// both full syllables and bare initial consonants; "광ㅎ" is a prefix of
+// "광화문".
+func HasChosungPrefix(s, query string) bool {
+	_, ok := hasChosungPrefix(s, query)
+	return ok
+}
+
+// IndexChosung returns byte index of the first match of query in s and
+// byte length of the match, or -1 if query is not present in s.
+// Query can have both full syllables and bare initial consonants.
+func IndexChosung(s, query string) (int, int) {
+	for i := range s {
+		if n, ok := hasChosungPrefix(s[i:], query); ok {
+			return i, n
+		}
+	}
+	if query == "" {
+		return 0, 0
+	}
+	return -1, 0
+}
+
+// ContainsChosung reports whether query matches within s.
+func ContainsChosung(s, query string) bool {
+	i, _ := IndexChosung(s, query)
+	return i >= 0
+}
+
+type chosungEntry struct {
+	key string // Chosung of s
+	s   string
+}
+
+// ChosungIndex is an in-memory index of strings for prefix search by
+// initial consonants. Search can be called concurrently, but not with Add.
+type ChosungIndex struct {
+	entries []chosungEntry // sorted by key
+}
+
+// NewChosungIndex creates a new ChosungIndex of given corpus.
+func NewChosungIndex(corpus []string) *ChosungIndex {
+	idx := &ChosungIndex{
+		entries: make([]chosungEntry, len(corpus)),
+	}
+	for i, s := range corpus {
+		idx.entries[i] = chosungEntry{Chosung(s), s}
+	}
+	sort.SliceStable(idx.entries, func(i, j int) bool {
+		return idx.entries[i].key < idx.entries[j].key
+	})
+	return idx
+}
+
+// Add adds s to the index.
+func (idx *ChosungIndex) Add(s string) {
+	e := chosungEntry{Chosung(s), s}
+	i := sort.Search(len(idx.entries), func(i int) bool {
+		return idx.entries[i].key > e.key
+	})
+	idx.entries = append(idx.entries, chosungEntry{})
+	copy(idx.entries[i+1:], idx.entries[i:])
+	idx.entries[i] = e
+}
+
+// Len returns number of strings in the index.
+func (idx *ChosungIndex) Len() int {
+	return len(idx.entries)
+}
+
+// lowerBound returns index of the first entry which key is not less
+// than given key.
+func (idx *ChosungIndex) lowerBound(key string) int {
+	return sort.Search(len(idx.entries), func(i int) bool {
+		return idx.entries[i].key >= key
+	})
+}
+
+// Search returns strings in the index which begin with query.
+// See HasChosungPrefix for the matching rule.
+func (idx *ChosungIndex) Search(query string) []string {
+	key := Chosung(query)
+	i := idx.lowerBound(key)
+
+	var ret []string
+	for ; i < len(idx.entries); i++ {
+		e := idx.entries[i]
+		if !strings.HasPrefix(e.key, key) {
+			break
+		}
+		if HasChosungPrefix(e.s, query) {
+			ret = append(ret, e.s)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,95 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collation
+
+import (
+	"golang.org/x/text/collate"
+	"golang.org/x/text/collate/build"
+)
+
+// Bases of primary weights in tables for collate. Leads come before
+// medials and medials before tails, so syllables broken into jamo still
+// sort one by one; 가나 < 각.
+const (
+	leadBase   = 0x1000
+	medialBase = 0x2000
+	tailBase   = 0x3000
+	noneOffset = 0xFFF // noneWeight
+)
+
+// Ranges of jamo in the tables. Jamo of the lone ranges never make a
+// syllable with others.
+var tableRanges = []struct {
+	first, last rune
+	lone        bool
+}{
+	{0x1100, 0x11FF, false}, // conjoining jamo
+	{0xA960, 0xA97C, false}, // Extended-A
+	{0xD7B0, 0xD7FB, false}, // Extended-B
+	{0x3131, 0x318E, true},  // compatibility jamo
+	{0xFFA1, 0xFFDC, true},  // halfwidth jamo
+}
+
+// Collate returns a collate.Collator which sorts Hangul in the order of c.
+// Options are passed to collate.NewFromTable. Precomposed syllables, NFD,
+// compatibility and halfwidth jamo are sorted as with c. Text other than
+// Hangul comes after Hangul in order of code points, and Fallback is not
+// used. SyllableFirst is applied to compatibility and halfwidth jamo only;
+// lone conjoining jamo can not be told from ones in syllables.
+func (c *Collator) Collate(o ...collate.Option) (*collate.Collator, error) {
+	b := build.NewBuilder()
+	for _, rng := range tableRanges {
+		for r := rng.first; r <= rng.last; r++ {
+			var blk block
+			blk.add(r)
+			if blk.empty() {
+				continue
+			}
+			if err := b.Add([]rune{r}, c.colElems(&blk, rng.lone), nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	w, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return collate.NewFromTable(w, o...), nil
+}
+
+// colElems returns collation elements of jamo in b, with the weights of
+// appendBlock as primary weights. Weights for missing lead and medial are
+// added only if lone.
+func (c *Collator) colElems(b *block, lone bool) [][]int {
+	var ces [][]int
+	add := func(base int, w uint16) {
+		if w == noneWeight {
+			ces = append(ces, []int{base + noneOffset})
+			return
+		}
+		ces = append(ces, []int{base + int(w)})
+	}
+
+	if len(b.l) == 0 && lone {
+		if c.SyllableFirst {
+			add(leadBase, noneWeight)
+		} else {
+			add(leadBase, 0) // before all leads; ㅏ < ㄱ
+		}
+	}
+	for _, l := range b.l {
+		add(leadBase, c.leadWeight(l))
+	}
+	if len(b.m) == 0 && lone && c.SyllableFirst {
+		add(medialBase, noneWeight)
+	}
+	for _, m := range b.m {
+		add(medialBase, c.medialWeight(m))
+	}
+	for _, t := range b.t {
+		add(tailBase, c.tailWeight(t))
+	}
+	return ces
+}
@@ -0,0 +1,341 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package collation sorts Hangul text by jamo, in the order of South or
+// North Korean dictionaries. Text in NFD, compatibility jamo and Old
+// Hangul are sorted along with precomposed syllables. Archaic jamo are
+// sorted in the order of KS X 1026-1; ᅀ between ㅅ and ㅇ.
+//
+// Collator sorts by its own keys. Options of golang.org/x/text/collate
+// can only be made in that package, so Collate builds a collate.Collator
+// in the order of a Collator instead.
+package collation
+
+import (
+	"bytes"
+	"sort"
+
+	hangul "github.com/suapapa/go_hangul"
+	"golang.org/x/text/collate"
+)
+
+// Order is an order of jamo
+type Order int
+
+// Orders of jamo
+const (
+	// SouthKorean is the order of KS X 1026-1, which is of South Korean
+	// dictionaries for modern jamo; ㄱ ㄲ ㄴ ㄷ ㄸ ... ㅎ, ㅏ ㅐ ㅑ ㅒ ... ㅣ.
+	SouthKorean Order = iota
+	// NorthKorean is the order of North Korean dictionaries. Double
+	// consonants come after single ones and initial ㅇ comes last;
+	// ㄱ ㄴ ㄷ ... ㅎ ㄲ ㄸ ㅃ ㅆ ㅉ ㅇ, ㅏ ㅑ ㅓ ... ㅣ ㅐ ㅒ ... ㅞ. Archaic
+	// jamo follow modern ones.
+	NorthKorean
+)
+
+// Modern jamo in the North Korean order
+var (
+	northLeads = []rune{
+		hangul.G, hangul.N, hangul.D, hangul.L, hangul.M, hangul.B,
+		hangul.S, hangul.J, hangul.C, hangul.K, hangul.T, hangul.P,
+		hangul.H, hangul.GG, hangul.DD, hangul.BB, hangul.SS, hangul.JJ,
+		hangul.ZS,
+	}
+	northMedials = []rune{
+		hangul.A, hangul.YA, hangul.EO, hangul.YEO, hangul.O, hangul.YO,
+		hangul.U, hangul.YU, hangul.EU, hangul.I, hangul.AE, hangul.YAE,
+		hangul.E, hangul.YE, hangul.OE, hangul.WI, hangul.YI, hangul.WA,
+		hangul.WEO, hangul.WAE, hangul.WE,
+	}
+	northTails = []rune{
+		hangul.G, hangul.GS, hangul.N, hangul.NJ, hangul.NH, hangul.D,
+		hangul.L, hangul.LG, hangul.LM, hangul.LB, hangul.LS, hangul.LT,
+		hangul.LP, hangul.LH, hangul.M, hangul.B, hangul.BS, hangul.S,
+		hangul.ZS, hangul.J, hangul.C, hangul.K, hangul.T, hangul.P,
+		hangul.H, hangul.GG, hangul.SS,
+	}
+)
+
+// weights holds weights of conjoining jamo
+type weights map[rune]uint16
+
+var orderWeights = []weights{
+	SouthKorean: southWeights(),
+	NorthKorean: northWeights(),
+}
+
+func southWeights() weights {
+	w := make(weights)
+	for _, js := range [][]rune{ksLeads, ksMedials, ksTails} {
+		for i, j := range js {
+			w[j] = uint16(i + 1)
+		}
+	}
+	return w
+}
+
+func northWeights() weights {
+	w := make(weights)
+	for i, c := range northLeads {
+		w[hangul.Lead(c)] = uint16(i + 1)
+	}
+	for i, c := range northMedials {
+		w[hangul.Medial(c)] = uint16(i + 1)
+	}
+	for i, c := range northTails {
+		w[hangul.Tail(c)] = uint16(i + 1)
+	}
+	return w
+}
+
+// Weights of jamo not in the order, archaic ones in NorthKorean, follow
+// all others in order of code points; conjoining jamo of U+1100-11FF,
+// then Extended-A and B.
+const (
+	archaicWeight = 0x100
+	extWeight     = 0x200
+	noneWeight    = 0xFFFF // no medial of a lone jamo, for SyllableFirst
+)
+
+// Collator sorts Hangul text in an order.
+type Collator struct {
+	Order
+	// SyllableFirst puts lone jamo after syllables start with it;
+	// 가 < 기 < ㄱ < 까. Otherwise lone jamo come first; ㄱ < 가 < 기.
+	SyllableFirst bool
+	// Fallback sorts text other than Hangul. They are sorted in order of
+	// code points if it is nil. Text other than Hangul comes before Hangul.
+	Fallback *collate.Collator
+}
+
+// New returns a new Collator in given order.
+func New(order Order) *Collator {
+	return &Collator{Order: order}
+}
+
+func (c *Collator) weights() weights {
+	if c.Order < 0 || int(c.Order) >= len(orderWeights) {
+		return orderWeights[SouthKorean]
+	}
+	return orderWeights[c.Order]
+}
+
+func (c *Collator) leadWeight(l rune) uint16 {
+	if w, ok := c.weights()[l]; ok {
+		return w
+	}
+	if l >= 0xA960 {
+		return extWeight + uint16(l-0xA960)
+	}
+	return archaicWeight + uint16(l-hangul.LeadG)
+}
+
+func (c *Collator) medialWeight(m rune) uint16 {
+	if w, ok := c.weights()[m]; ok {
+		return w
+	}
+	if m >= 0xD7B0 {
+		return extWeight + uint16(m-0xD7B0)
+	}
+	return archaicWeight + uint16(m-hangul.MedialA)
+}
+
+func (c *Collator) tailWeight(t rune) uint16 {
+	if w, ok := c.weights()[t]; ok {
+		return w
+	}
+	if t >= 0xD7CB {
+		return extWeight + uint16(t-0xD7CB)
+	}
+	return archaicWeight + uint16(t-hangul.TailG)
+}
+
+// block holds jamo of a syllable block in conjoining jamo
+type block struct {
+	l, m, t []rune
+}
+
+func (b *block) empty() bool {
+	return len(b.l) == 0 && len(b.m) == 0 && len(b.t) == 0
+}
+
+// add adds r to the block if it is Hangul. Combining marks and fillers
+// are ignored.
+func (b *block) add(r rune) {
+	if r >= 0xAC00 && r <= 0xD7A3 {
+		l, m, t := hangul.Split(r)
+		b.l = append(b.l, l)
+		b.m = append(b.m, m)
+		if t != 0 {
+			b.t = append(b.t, t)
+		}
+		return
+	}
+
+	// Conjoining jamo
+	switch {
+	case hangul.Lead(r) == r:
+		b.l = append(b.l, r)
+		return
+	case hangul.Medial(r) == r:
+		b.m = append(b.m, r)
+		return
+	case hangul.Tail(r) == r:
+		b.t = append(b.t, r)
+		return
+	}
+
+	// Compatibility or halfwidth jamo
+	j := hangul.CompatJamo(r)
+	switch {
+	case hangul.IsMoeum(j):
+		b.m = append(b.m, hangul.Medial(j))
+	case hangul.IsJaeum(j):
+		// Lone consonant; ㄳ as ㄱ followed by ㅅ
+		es, ok := hangul.SplitMultiElement(j)
+		if !ok || hangul.Lead(j) != 0 {
+			es = []rune{j}
+		}
+		for _, e := range es {
+			if l := hangul.Lead(e); l != 0 {
+				b.l = append(b.l, l)
+			} else if t := hangul.Tail(e); t != 0 {
+				b.t = append(b.t, t)
+			}
+		}
+	}
+}
+
+func appendWeight(key []byte, w uint16) []byte {
+	return append(key, byte(w>>8), byte(w))
+}
+
+// appendEscaped appends b to key. It escapes 0 and appends terminator,
+// so the bytes sort before longer ones which have them as prefix.
+func appendEscaped(key, b []byte) []byte {
+	for _, c := range b {
+		key = append(key, c)
+		if c == 0 {
+			key = append(key, 1)
+		}
+	}
+	return append(key, 0, 0)
+}
+
+// Classes of elements of a key
+const (
+	classOther  = 1
+	classHangul = 2
+)
+
+func (c *Collator) appendBlock(key []byte, b *block) []byte {
+	key = append(key, classHangul)
+	if len(b.l) == 0 && c.SyllableFirst {
+		key = appendWeight(key, noneWeight)
+	}
+	for _, l := range b.l {
+		key = appendWeight(key, c.leadWeight(l))
+	}
+	key = append(key, 0, 0)
+	if len(b.m) == 0 && c.SyllableFirst {
+		key = appendWeight(key, noneWeight)
+	}
+	for _, m := range b.m {
+		key = appendWeight(key, c.medialWeight(m))
+	}
+	key = append(key, 0, 0)
+	for _, t := range b.t {
+		key = appendWeight(key, c.tailWeight(t))
+	}
+	return append(key, 0, 0)
+}
+
+func (c *Collator) appendOther(key []byte, s string) []byte {
+	key = append(key, classOther)
+	if c.Fallback == nil {
+		return appendEscaped(key, []byte(s))
+	}
+	var buf collate.Buffer
+	return appendEscaped(key, c.Fallback.KeyFromString(&buf, s))
+}
+
+// KeyFromString returns sort key of s. Keys can be compared with
+// bytes.Compare.
+func (c *Collator) KeyFromString(s string) []byte {
+	var key []byte
+	other := -1 // start of text other than Hangul
+	for it := hangul.Syllables(s); it.Next(); {
+		var b block
+		for _, r := range it.Text() {
+			b.add(r)
+		}
+		if b.empty() {
+			if other < 0 {
+				other = it.Offset()
+			}
+			continue
+		}
+		if other >= 0 {
+			key = c.appendOther(key, s[other:it.Offset()])
+			other = -1
+		}
+		key = c.appendBlock(key, &b)
+	}
+	if other >= 0 {
+		key = c.appendOther(key, s[other:])
+	}
+	return key
+}
+
+// Key returns sort key of b. See KeyFromString.
+func (c *Collator) Key(b []byte) []byte {
+	return c.KeyFromString(string(b))
+}
+
+// Compare returns an integer comparing a and b; 0 if a == b,
+// -1 if a < b, and +1 if a > b.
+func (c *Collator) Compare(a, b []byte) int {
+	return bytes.Compare(c.Key(a), c.Key(b))
+}
+
+// CompareString returns an integer comparing a and b; 0 if a == b,
+// -1 if a < b, and +1 if a > b.
+func (c *Collator) CompareString(a, b string) int {
+	return bytes.Compare(c.KeyFromString(a), c.KeyFromString(b))
+}
+
+type keySorter struct {
+	collate.Lister
+	keys [][]byte
+}
+
+func (s *keySorter) Less(i, j int) bool {
+	return bytes.Compare(s.keys[i], s.keys[j]) < 0
+}
+
+func (s *keySorter) Swap(i, j int) {
+	s.Lister.Swap(i, j)
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+// Sort sorts elements of x. Each key is computed only once.
+func (c *Collator) Sort(x collate.Lister) {
+	s := &keySorter{x, make([][]byte, x.Len())}
+	for i := range s.keys {
+		s.keys[i] = c.Key(x.Bytes(i))
+	}
+	sort.Stable(s)
+}
+
+type stringLister []string
+
+func (l stringLister) Len() int           { return len(l) }
+func (l stringLister) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l stringLister) Bytes(i int) []byte { return []byte(l[i]) }
+
+// SortStrings sorts x.
+func (c *Collator) SortStrings(x []string) {
+	c.Sort(stringLister(x))
+}
@@ -0,0 +1,149 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package collation
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+func TestSouthKorean(t *testing.T) {
+	c := New(SouthKorean)
+	words := []string{"하늘", "가방", "까치", "ㄱ", "나비", "아이", "가", "각", "갂", "ㅏ"}
+	c.SortStrings(words)
+	expected := "ㅏ ㄱ 가 가방 각 갂 까치 나비 아이 하늘"
+	if actual := strings.Join(words, " "); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+
+	// NFD sorts with NFC, and compatibility jamo with conjoining jamo
+	pairs := [][2]string{
+		{"가방", "가방"},
+		{"ㄱ", "ᄀ"},
+		{"ㄱ", "ﾡ"},
+	}
+	for _, p := range pairs {
+		if c.CompareString(p[0], p[1]) != 0 {
+			t.Errorf("%q and %q should be equal", p[0], p[1])
+		}
+	}
+	if c.CompareString("가", "나") >= 0 || c.Compare([]byte("다"), []byte("나")) <= 0 {
+		t.Errorf("unexpected Compare")
+	}
+}
+
+func TestNorthKorean(t *testing.T) {
+	c := New(NorthKorean)
+	words := []string{"아이", "까치", "하늘", "가방", "개", "거미", "갑", "갇"}
+	c.SortStrings(words)
+	// Double consonants after ㅎ, and initial ㅇ last; ㅓ before ㅐ
+	expected := "가방 갇 갑 거미 개 하늘 까치 아이"
+	if actual := strings.Join(words, " "); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestSyllableFirst(t *testing.T) {
+	c := New(SouthKorean)
+	c.SyllableFirst = true
+	words := []string{"까", "ㄱ", "기", "가", "ㅏ", "하"}
+	c.SortStrings(words)
+	expected := "가 기 ㄱ 까 하 ㅏ"
+	if actual := strings.Join(words, " "); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestOldHangul(t *testing.T) {
+	c := New(SouthKorean)
+	// ᄒᆞᆫ follows 히 as ㆍ follows modern vowels
+	words := []string{"ᄒᆞᆫ", "히", "하"}
+	c.SortStrings(words)
+	expected := "하 히 ᄒᆞᆫ"
+	if actual := strings.Join(words, " "); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+
+	// KS X 1026-1; ᄓ(ㄴㄱ) after ㄴ, ᅀ between ㅅ and ㅇ, ᅶ(ㅏㅗ) before
+	// ㅐ(ㅏㅣ) and ᇫ(ㅿ) between ㅅ and ㅇ in tail
+	words = []string{"ᅀᅡ", "하", "ᄓᅡ", "다", "사", "아", "나", "개", "가ᇫ", "각", "갓", "강", "ᄀᅶ"}
+	c.SortStrings(words)
+	expected = "각 갓 가ᇫ 강 ᄀᅶ 개 나 ᄓᅡ 다 사 ᅀᅡ 아 하"
+	if actual := strings.Join(words, " "); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+
+	// Archaic jamo follow modern ones in NorthKorean
+	c = New(NorthKorean)
+	words = []string{"ᅀᅡ", "하", "ᄓᅡ", "아", "나"}
+	c.SortStrings(words)
+	expected = "나 하 아 ᄓᅡ ᅀᅡ"
+	if actual := strings.Join(words, " "); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestFallback(t *testing.T) {
+	words := []string{"나", "b", "가", "A", "a", "B"}
+	c := New(SouthKorean)
+	c.SortStrings(words)
+	if actual := strings.Join(words, " "); actual != "A B a b 가 나" {
+		t.Errorf("without fallback: got %s", actual)
+	}
+
+	c.Fallback = collate.New(language.English)
+	c.SortStrings(words)
+	if actual := strings.Join(words, " "); actual != "a A b B 가 나" {
+		t.Errorf("with fallback: got %s", actual)
+	}
+
+	// Prefix sorts first
+	if c.CompareString("가a", "가ab") >= 0 || c.CompareString("가", "가a") >= 0 {
+		t.Errorf("prefix should sort first")
+	}
+}
+
+func TestCollate(t *testing.T) {
+	cases := []struct {
+		c        *Collator
+		words    []string
+		expected string
+	}{
+		{New(SouthKorean), []string{"하늘", "가방", "까치", "ㄱ", "나비", "아이", "가", "각", "갂", "ㅏ"},
+			"ㅏ ㄱ 가 가방 각 갂 까치 나비 아이 하늘"},
+		{New(SouthKorean), []string{"ᅀᅡ", "하", "ᄓᅡ", "다", "사", "아", "나", "가나", "각"},
+			"가나 각 나 ᄓᅡ 다 사 ᅀᅡ 아 하"},
+		{New(NorthKorean), []string{"아이", "까치", "하늘", "가방", "개", "거미", "갑", "갇"},
+			"가방 갇 갑 거미 개 하늘 까치 아이"},
+		{&Collator{Order: SouthKorean, SyllableFirst: true}, []string{"까", "ㄱ", "기", "가", "ㅏ", "하"},
+			"가 기 ㄱ 까 하 ㅏ"},
+	}
+	for _, c := range cases {
+		cl, err := c.c.Collate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cl.SortStrings(c.words)
+		if actual := strings.Join(c.words, " "); actual != c.expected {
+			t.Errorf("expected %s, got %s", c.expected, actual)
+		}
+	}
+
+	cl, err := New(SouthKorean).Collate(collate.Loose)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range [][2]string{{"가방", "가방"}, {"ㄱ", "ᄀ"}, {"ㄱ", "ﾡ"}} {
+		if cl.CompareString(p[0], p[1]) != 0 {
+			t.Errorf("%q and %q should be equal", p[0], p[1])
+		}
+	}
+	if cl.CompareString("가나", "각") >= 0 || cl.CompareString("가", "a") >= 0 {
+		t.Errorf("unexpected CompareString")
+	}
+}
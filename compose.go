@@ -0,0 +1,177 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import (
+	"io"
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+)
+
+// ComposeString recomposes conjoining jamo sequences (L+V and L+V+T) in s
+// to precomposed syllables. Non-Hangul text is left untouched.
+func ComposeString(s string) string {
+	out, _, _ := transform.String(NewComposer(), s)
+	return out
+}
+
+// DecomposeString decomposes precomposed syllables in s to conjoining jamo.
+// Non-Hangul text is left untouched.
+func DecomposeString(s string) string {
+	out, _, _ := transform.String(NewDecomposer(), s)
+	return out
+}
+
+// NewComposeReader creates io.Reader which recomposes Hangul read from r
+func NewComposeReader(r io.Reader) io.Reader {
+	return transform.NewReader(r, NewComposer())
+}
+
+// NewDecomposeReader creates io.Reader which decomposes Hangul read from r
+func NewDecomposeReader(r io.Reader) io.Reader {
+	return transform.NewReader(r, NewDecomposer())
+}
+
+// NewComposer returns a transform.Transformer which recomposes
+// conjoining jamo to precomposed syllables.
+func NewComposer() transform.Transformer {
+	return composer{}
+}
+
+// NewDecomposer returns a transform.Transformer which decomposes
+// precomposed syllables to conjoining jamo.
+func NewDecomposer() transform.Transformer {
+	return decomposer{}
+}
+
+func isSyllable(r rune) bool {
+	return 0xAC00 <= r && r <= 0xD7A3
+}
+
+type composer struct{ transform.NopResetter }
+
+// Transform implements transform.Transformer
+func (composer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		r, n, short := composeNext(src[nSrc:], atEOF)
+		if short {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		if r == utf8.RuneError && n == 1 {
+			// Pass invalid bytes through as is.
+			if nDst >= len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			dst[nDst] = src[nSrc]
+			nDst++
+			nSrc++
+			continue
+		}
+
+		if nDst+utf8.RuneLen(r) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+		nSrc += n
+	}
+	return nDst, nSrc, nil
+}
+
+// nextRune decodes a rune from head of b. It reports short if b holds only
+// a partial rune and more input may follow.
+func nextRune(b []byte, atEOF bool) (r rune, n int, short bool) {
+	if len(b) == 0 {
+		return 0, 0, !atEOF
+	}
+	if !atEOF && !utf8.FullRune(b) {
+		return 0, 0, true
+	}
+	r, n = utf8.DecodeRune(b)
+	return r, n, false
+}
+
+// composeNext returns the next rune of composed text and how many bytes of
+// b were consumed for it.
+func composeNext(b []byte, atEOF bool) (r rune, n int, short bool) {
+	r, n, short = nextRune(b, atEOF)
+	if short {
+		return
+	}
+
+	switch {
+	case IsLead(r):
+		m, mn, mshort := nextRune(b[n:], atEOF)
+		if mshort {
+			return 0, 0, true
+		}
+		if !IsMedial(m) {
+			return r, n, false
+		}
+		l := r
+		r = Join(l, m, 0)
+		n += mn
+		t, tn, tshort := nextRune(b[n:], atEOF)
+		if tshort {
+			return 0, 0, true
+		}
+		if IsTail(t) {
+			r = Join(l, m, t)
+			n += tn
+		}
+	case isSyllable(r):
+		if (r-0xAC00)%28 != 0 {
+			break
+		}
+		t, tn, tshort := nextRune(b[n:], atEOF)
+		if tshort {
+			return 0, 0, true
+		}
+		if IsTail(t) {
+			l, m, _ := Split(r)
+			r = Join(l, m, t)
+			n += tn
+		}
+	}
+	return r, n, false
+}
+
+type decomposer struct{ transform.NopResetter }
+
+// Transform implements transform.Transformer
+func (decomposer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		r, n, short := nextRune(src[nSrc:], atEOF)
+		if short {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		if !isSyllable(r) {
+			if nDst+n > len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], src[nSrc:nSrc+n])
+			nSrc += n
+			continue
+		}
+
+		l, m, t := Split(r)
+		size := 6 // lead and medial are 3 bytes each in UTF-8
+		if t != 0 {
+			size += 3
+		}
+		if nDst+size > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], l)
+		nDst += utf8.EncodeRune(dst[nDst:], m)
+		if t != 0 {
+			nDst += utf8.EncodeRune(dst[nDst:], t)
+		}
+		nSrc += n
+	}
+	return nDst, nSrc, nil
+}
@@ -0,0 +1,278 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package conjugate inflects Korean verbs and adjectives(용언) in
+// dictionary form with endings(어미); 가다 to 가요 or 갔어요, 먹다 to 먹어요.
+// Vowel harmony, contractions and the major irregular classes are
+// handled.
+package conjugate
+
+import (
+	"errors"
+
+	hangul "github.com/suapapa/go_hangul"
+)
+
+var (
+	// ErrNotPredicate is returned if a word is not a predicate in
+	// dictionary form; a stem of Hangul syllables followed by 다.
+	ErrNotPredicate = errors.New("not a predicate in dictionary form")
+	// ErrEnding is returned for unknown endings.
+	ErrEnding = errors.New("unknown ending")
+)
+
+// Ending is an ending to conjugate a predicate with
+type Ending int
+
+// Endings. Honorific can be combined with any of them;
+// PolitePresent|Honorific for 가세요.
+const (
+	Plain         Ending = iota // -다; 가다
+	Informal                    // -아/어; 가, 먹어
+	InformalPast                // -았어/었어; 갔어, 먹었어
+	PolitePresent               // -아요/어요; 가요, 먹어요
+	PolitePast                  // -았어요/었어요; 갔어요, 먹었어요
+	Formal                      // -(스)ㅂ니다; 갑니다, 먹습니다
+	FormalPast                  // -았습니다/었습니다; 갔습니다
+	Conjunctive                 // -고; 가고, 먹고
+	Conditional                 // -(으)면; 가면, 먹으면
+	Causal                      // -(으)니까; 가니까, 먹으니까
+
+	Honorific Ending = 1 << 8 // -(으)시-; 가시다, 가세요, 가셨어요
+)
+
+// Conjugate conjugates verb, a verb or an adjective in dictionary form,
+// with ending e; Conjugate("듣다", PolitePresent) is "들어요". Irregular
+// predicates are conjugated in the class of ClassOf.
+func Conjugate(verb string, e Ending) (string, error) {
+	stem, err := stemOf(verb)
+	if err != nil {
+		return "", err
+	}
+	if v, ok := vowelStems[verb]; ok && (e != Plain && e != Formal && e != Conjunctive) {
+		// 뵙 takes consonant endings only; 봬요 and 뵈면 are of 뵈다
+		return Conjugate(v, e)
+	}
+	p := &predicate{stem, ClassOf(verb)}
+
+	if e&Honorific != 0 {
+		e &^= Honorific
+		p = &predicate{[]rune(p.withEu("시")), Regular}
+		if e == PolitePresent {
+			// 가시어요 is contracted to 가세요
+			return string(p.stem[:len(p.stem)-1]) + "세요", nil
+		}
+	}
+
+	switch e {
+	case Plain:
+		return string(p.stem) + "다", nil
+	case Informal:
+		if p.class == Copula {
+			return string(p.stem) + "야", nil // 이야, 아니야
+		}
+		return p.infinitive(), nil
+	case InformalPast:
+		return p.past() + "어", nil
+	case PolitePresent:
+		if p.class == Copula {
+			return string(p.stem) + "에요", nil // 이에요, 아니에요
+		}
+		return p.infinitive() + "요", nil
+	case PolitePast:
+		return p.past() + "어요", nil
+	case Formal:
+		return p.formal(), nil
+	case FormalPast:
+		return p.past() + "습니다", nil
+	case Conjunctive:
+		return string(p.stem) + "고", nil
+	case Conditional:
+		return p.withEu("면"), nil
+	case Causal:
+		return p.withEu("니까"), nil
+	}
+	return "", ErrEnding
+}
+
+type predicate struct {
+	stem  []rune
+	class Class
+}
+
+// split returns the stem but the last syllable, and jamo of the last one
+func (p *predicate) split() (head string, l, m, t rune) {
+	n := len(p.stem) - 1
+	l, m, t = hangul.Split(p.stem[n])
+	return string(p.stem[:n]), l, m, t
+}
+
+// bright reports whether medial m takes 아 rather than 어; ㅏ and ㅗ, and
+// the vowels made with them but ㅐ and ㅚ.
+func bright(m rune) bool {
+	h, _ := hangul.VowelHarmony(m)
+	f, _ := hangul.VowelFeatures(m)
+	return h == hangul.Yang && f.Backness == hangul.Back
+}
+
+// aeo returns 아 or 어 to follow a syllable with medial m
+func aeo(m rune) string {
+	if bright(m) {
+		return "아"
+	}
+	return "어"
+}
+
+// prevMedial returns medial of the syllable before the last one. It
+// returns ㅓ for monosyllabic stems, which take 어; 크다 to 커요.
+func (p *predicate) prevMedial() rune {
+	if len(p.stem) < 2 {
+		return hangul.MedialEO
+	}
+	_, m, _ := hangul.Split(p.stem[len(p.stem)-2])
+	return m
+}
+
+// infinitive returns the stem with -아/어, contracted if possible;
+// 가다 to 가, 오다 to 와, 먹다 to 먹어.
+func (p *predicate) infinitive() string {
+	head, l, m, t := p.split()
+	join := func(l, m, t rune) string {
+		return head + string(hangul.Join(l, m, t))
+	}
+
+	switch p.class {
+	case IrregularYeo:
+		return join(l, hangul.MedialAE, 0) // 해
+	case IrregularD:
+		return join(l, m, hangul.TailL) + aeo(m) // 들어
+	case IrregularB:
+		if len(p.stem) == 1 && m == hangul.MedialO {
+			return join(l, m, 0) + "와" // 도와, 고와
+		}
+		return join(l, m, 0) + "워" // 추워, 고마워
+	case IrregularS:
+		return join(l, m, 0) + aeo(m) // 지어, 나아
+	case IrregularH:
+		return join(l, hMedial(p.stem, m), 0) // 하얘, 그래
+	case IrregularReu:
+		if len(p.stem) > 1 {
+			// 모르 to 몰라; ㄹ moves to the previous syllable
+			pm := p.prevMedial()
+			pl, _, _ := hangul.Split(p.stem[len(p.stem)-2])
+			return string(p.stem[:len(p.stem)-2]) +
+				string(hangul.Join(pl, pm, hangul.TailL)) +
+				string(hangul.Join(hangul.LeadR, aOrEo(pm), 0))
+		}
+	case IrregularReo:
+		return string(p.stem) + "러" // 푸르러
+	case IrregularU:
+		return join(l, hangul.MedialEO, 0) // 퍼
+	case Copula:
+		return string(p.stem) + "어" // 이었어요, 아니었어요
+	}
+
+	if t != 0 {
+		return string(p.stem) + aeo(m) // 먹어, 잡아
+	}
+	switch m {
+	case hangul.MedialA, hangul.MedialEO, hangul.MedialYEO,
+		hangul.MedialAE, hangul.MedialE:
+		return string(p.stem) // 가, 서, 켜, 내, 세
+	case hangul.MedialO:
+		return join(l, hangul.MedialWA, 0) // 와, 봐
+	case hangul.MedialU:
+		return join(l, hangul.MedialWEO, 0) // 줘
+	case hangul.MedialI:
+		if uncontracted[string(p.stem)] {
+			return string(p.stem) + "어" // 피어, not 펴
+		}
+		return join(l, hangul.MedialYEO, 0) // 쳐, 마셔
+	case hangul.MedialOE:
+		return join(l, hangul.MedialWAE, 0) // 돼
+	case hangul.MedialEU:
+		return join(l, aOrEo(p.prevMedial()), 0) // 커, 아파
+	}
+	return string(p.stem) + aeo(m) // 쉬어
+}
+
+// aOrEo returns medial ㅏ or ㅓ to follow a syllable with medial m
+func aOrEo(m rune) rune {
+	if bright(m) {
+		return hangul.MedialA
+	}
+	return hangul.MedialEO
+}
+
+// hMedial returns medial of ㅎ irregular stem merged with 아/어;
+// 하얗 to 하얘, 그렇 to 그래, 누렇 to 누레.
+func hMedial(stem []rune, m rune) rune {
+	switch m {
+	case hangul.MedialA:
+		return hangul.MedialAE
+	case hangul.MedialYA:
+		return hangul.MedialYAE
+	case hangul.MedialYEO:
+		return hangul.MedialYE
+	case hangul.MedialEO:
+		// 이렇다, 그렇다, 저렇다 and 어떻다
+		if len(stem) == 2 {
+			switch stem[0] {
+			case '이', '그', '저', '어':
+				return hangul.MedialAE
+			}
+		}
+		return hangul.MedialE
+	}
+	return m
+}
+
+// past returns the infinitive with ㅆ; 가다 to 갔, 먹다 to 먹었.
+func (p *predicate) past() string {
+	inf := []rune(p.infinitive())
+	n := len(inf) - 1
+	l, m, _ := hangul.Split(inf[n])
+	inf[n] = hangul.Join(l, m, hangul.TailSS)
+	return string(inf)
+}
+
+// withEu returns the stem with an ending begins with optional 으;
+// 가면, 먹으면, 들으면, 도우면, 살면, 사니까.
+func (p *predicate) withEu(ending string) string {
+	head, l, m, t := p.split()
+	join := func(l, m, t rune) string {
+		return head + string(hangul.Join(l, m, t))
+	}
+
+	switch {
+	case hangul.LastConsonant(string(p.stem)) == 0:
+		return string(p.stem) + ending
+	case p.class == IrregularD:
+		return join(l, m, hangul.TailL) + "으" + ending
+	case p.class == IrregularB:
+		return join(l, m, 0) + "우" + ending
+	case p.class == IrregularS:
+		return join(l, m, 0) + "으" + ending
+	case p.class == IrregularH:
+		return join(l, m, 0) + ending // 하야면, 그러면
+	case t == hangul.TailL:
+		// ㄹ drops before ㄴ and ㅅ; 사니까, 사시다
+		el, _, _ := hangul.Split([]rune(ending)[0])
+		if el == hangul.LeadN || el == hangul.LeadS {
+			return join(l, m, 0) + ending
+		}
+		return string(p.stem) + ending
+	}
+	return string(p.stem) + "으" + ending
+}
+
+// formal returns the stem with -(스)ㅂ니다; 갑니다, 삽니다, 먹습니다.
+func (p *predicate) formal() string {
+	head, l, m, _ := p.split()
+	switch hangul.LastConsonant(string(p.stem)) {
+	case 0, hangul.TailL:
+		return head + string(hangul.Join(l, m, hangul.TailB)) + "니다"
+	}
+	return string(p.stem) + "습니다"
+}
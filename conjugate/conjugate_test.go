@@ -0,0 +1,159 @@
+// Copyright 2012, Homin Lee. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conjugate
+
+import "testing"
+
+func TestConjugate(t *testing.T) {
+	cases := []struct {
+		verb                         string
+		polite, past, formal, cond   string
+		causal, honorific, honorPast string
+	}{
+		{"가다", "가요", "갔어요", "갑니다", "가면", "가니까", "가세요", "가셨어요"},
+		{"먹다", "먹어요", "먹었어요", "먹습니다", "먹으면", "먹으니까", "먹으세요", "먹으셨어요"},
+		{"하다", "해요", "했어요", "합니다", "하면", "하니까", "하세요", "하셨어요"},
+		{"공부하다", "공부해요", "공부했어요", "공부합니다", "공부하면", "공부하니까", "공부하세요", "공부하셨어요"},
+		{"오다", "와요", "왔어요", "옵니다", "오면", "오니까", "오세요", "오셨어요"},
+		{"보다", "봐요", "봤어요", "봅니다", "보면", "보니까", "보세요", "보셨어요"},
+		{"주다", "줘요", "줬어요", "줍니다", "주면", "주니까", "주세요", "주셨어요"},
+		{"마시다", "마셔요", "마셨어요", "마십니다", "마시면", "마시니까", "마시세요", "마시셨어요"},
+		{"되다", "돼요", "됐어요", "됩니다", "되면", "되니까", "되세요", "되셨어요"},
+		{"보내다", "보내요", "보냈어요", "보냅니다", "보내면", "보내니까", "보내세요", "보내셨어요"},
+		{"쉬다", "쉬어요", "쉬었어요", "쉽니다", "쉬면", "쉬니까", "쉬세요", "쉬셨어요"},
+		{"잡다", "잡아요", "잡았어요", "잡습니다", "잡으면", "잡으니까", "잡으세요", "잡으셨어요"},
+		{"좋다", "좋아요", "좋았어요", "좋습니다", "좋으면", "좋으니까", "좋으세요", "좋으셨어요"},
+		{"살다", "살아요", "살았어요", "삽니다", "살면", "사니까", "사세요", "사셨어요"},
+		{"만들다", "만들어요", "만들었어요", "만듭니다", "만들면", "만드니까", "만드세요", "만드셨어요"},
+		{"쓰다", "써요", "썼어요", "씁니다", "쓰면", "쓰니까", "쓰세요", "쓰셨어요"},
+		{"아프다", "아파요", "아팠어요", "아픕니다", "아프면", "아프니까", "아프세요", "아프셨어요"},
+		{"따르다", "따라요", "따랐어요", "따릅니다", "따르면", "따르니까", "따르세요", "따르셨어요"},
+		{"피다", "피어요", "피었어요", "핍니다", "피면", "피니까", "피세요", "피셨어요"},
+		{"치다", "쳐요", "쳤어요", "칩니다", "치면", "치니까", "치세요", "치셨어요"},
+		{"지다", "져요", "졌어요", "집니다", "지면", "지니까", "지세요", "지셨어요"},
+		{"찌다", "쪄요", "쪘어요", "찝니다", "찌면", "찌니까", "찌세요", "찌셨어요"},
+		{"비다", "비어요", "비었어요", "빕니다", "비면", "비니까", "비세요", "비셨어요"},
+		{"모이다", "모여요", "모였어요", "모입니다", "모이면", "모이니까", "모이세요", "모이셨어요"},
+		// Copula
+		{"이다", "이에요", "이었어요", "입니다", "이면", "이니까", "이세요", "이셨어요"},
+		{"아니다", "아니에요", "아니었어요", "아닙니다", "아니면", "아니니까", "아니세요", "아니셨어요"},
+		// Irregular
+		{"듣다", "들어요", "들었어요", "듣습니다", "들으면", "들으니까", "들으세요", "들으셨어요"},
+		{"알아듣다", "알아들어요", "알아들었어요", "알아듣습니다", "알아들으면", "알아들으니까", "알아들으세요", "알아들으셨어요"},
+		{"돕다", "도와요", "도왔어요", "돕습니다", "도우면", "도우니까", "도우세요", "도우셨어요"},
+		{"춥다", "추워요", "추웠어요", "춥습니다", "추우면", "추우니까", "추우세요", "추우셨어요"},
+		{"짓다", "지어요", "지었어요", "짓습니다", "지으면", "지으니까", "지으세요", "지으셨어요"},
+		{"낫다", "나아요", "나았어요", "낫습니다", "나으면", "나으니까", "나으세요", "나으셨어요"},
+		{"하얗다", "하얘요", "하얬어요", "하얗습니다", "하야면", "하야니까", "하야세요", "하야셨어요"},
+		{"그렇다", "그래요", "그랬어요", "그렇습니다", "그러면", "그러니까", "그러세요", "그러셨어요"},
+		{"모르다", "몰라요", "몰랐어요", "모릅니다", "모르면", "모르니까", "모르세요", "모르셨어요"},
+		{"부르다", "불러요", "불렀어요", "부릅니다", "부르면", "부르니까", "부르세요", "부르셨어요"},
+		{"푸르다", "푸르러요", "푸르렀어요", "푸릅니다", "푸르면", "푸르니까", "푸르세요", "푸르셨어요"},
+		{"뵙다", "봬요", "뵀어요", "뵙습니다", "뵈면", "뵈니까", "뵈세요", "뵈셨어요"},
+		{"여쭙다", "여쭤요", "여쭸어요", "여쭙습니다", "여쭈면", "여쭈니까", "여쭈세요", "여쭈셨어요"},
+		{"푸다", "퍼요", "펐어요", "풉니다", "푸면", "푸니까", "푸세요", "푸셨어요"},
+	}
+	for _, c := range cases {
+		endings := []struct {
+			e        Ending
+			expected string
+		}{
+			{PolitePresent, c.polite},
+			{PolitePast, c.past},
+			{Formal, c.formal},
+			{Conditional, c.cond},
+			{Causal, c.causal},
+			{PolitePresent | Honorific, c.honorific},
+			{PolitePast | Honorific, c.honorPast},
+		}
+		for _, e := range endings {
+			actual, err := Conjugate(c.verb, e.e)
+			if err != nil || actual != e.expected {
+				t.Errorf("Conjugate(%q, %d): expected %s, got %s, %v", c.verb, e.e, e.expected, actual, err)
+			}
+		}
+	}
+}
+
+func TestEndings(t *testing.T) {
+	cases := []struct {
+		verb     string
+		e        Ending
+		expected string
+	}{
+		{"먹다", Plain, "먹다"},
+		{"먹다", Plain | Honorific, "먹으시다"},
+		{"가다", Informal, "가"},
+		{"먹다", InformalPast, "먹었어"},
+		{"듣다", FormalPast, "들었습니다"},
+		{"듣다", Conjunctive, "듣고"},
+		{"돕다", Conjunctive, "돕고"},
+		{"가다", Formal | Honorific, "가십니다"},
+		{"읽다", Conditional | Honorific, "읽으시면"},
+		{"살다", Conjunctive | Honorific, "사시고"},
+		{"이다", Informal, "이야"},
+		{"아니다", Informal, "아니야"},
+		{"아니다", FormalPast, "아니었습니다"},
+		{"이다", Conjunctive, "이고"},
+		{"뵙다", Conjunctive, "뵙고"},
+		{"뵙다", Plain, "뵙다"},
+	}
+	for _, c := range cases {
+		actual, err := Conjugate(c.verb, c.e)
+		if err != nil || actual != c.expected {
+			t.Errorf("Conjugate(%q, %d): expected %s, got %s, %v", c.verb, c.e, c.expected, actual, err)
+		}
+	}
+
+	for _, verb := range []string{"", "다", "먹", "abc다", "x르다", "ㄱ하다"} {
+		if _, err := Conjugate(verb, PolitePresent); err != ErrNotPredicate {
+			t.Errorf("Conjugate(%q): expected %v, got %v", verb, ErrNotPredicate, err)
+		}
+	}
+	if _, err := Conjugate("가다", Ending(100)); err != ErrEnding {
+		t.Errorf("expected %v, got %v", ErrEnding, err)
+	}
+}
+
+func TestClassOf(t *testing.T) {
+	cases := []struct {
+		verb     string
+		expected Class
+	}{
+		{"먹다", Regular},
+		{"닫다", Regular},
+		{"듣다", IrregularD},
+		{"엿듣다", IrregularD},
+		{"묻다", IrregularD},
+		{"파묻다", Regular},
+		{"입다", Regular},
+		{"아름답다", IrregularB},
+		{"씻다", Regular},
+		{"짓다", IrregularS},
+		{"빨갛다", IrregularH},
+		{"넣다", Regular},
+		{"빠르다", IrregularReu},
+		{"들르다", Regular},
+		{"푸르다", IrregularReo},
+		{"운동하다", IrregularYeo},
+		{"이다", Copula},
+		{"아니다", Copula},
+		{"모이다", Regular},
+	}
+	for _, c := range cases {
+		if actual := ClassOf(c.verb); actual != c.expected {
+			t.Errorf("ClassOf(%q): expected %d, got %d", c.verb, c.expected, actual)
+		}
+	}
+
+	RegisterPredicate("굽다", Regular) // to bend
+	if actual, _ := Conjugate("굽다", PolitePresent); actual != "굽어요" {
+		t.Errorf("expected 굽어요, got %s", actual)
+	}
+	RegisterPredicate("굽다", IrregularB) // to roast
+	if actual, _ := Conjugate("굽다", PolitePresent); actual != "구워요" {
+		t.Errorf("expected 구워요, got %s", actual)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package conjugate
+
+import (
+	"strings"
+	"sync"
+
+	hangul "github.com/suapapa/go_hangul"
+)
+
+// Class is a conjugation class of a predicate
+type Class int
+
+// Conjugation classes. Stems end with ㄹ or ㅡ are regular; 살다 to 사니까
+// and 쓰다 to 써요.
+const (
+	Regular      Class = iota
+	IrregularD         // ㄷ 불규칙; 듣다 to 들어요
+	IrregularB         // ㅂ 불규칙; 돕다 to 도와요, 춥다 to 추워요
+	IrregularS         // ㅅ 불규칙; 짓다 to 지어요
+	IrregularH         // ㅎ 불규칙; 하얗다 to 하얘요
+	IrregularReu       // 르 불규칙; 모르다 to 몰라요
+	IrregularReo       // 러 불규칙; 푸르다 to 푸르러요
+	IrregularU         // 우 불규칙; 푸다 to 퍼요
+	IrregularYeo       // 여 불규칙; 하다 to 해요
+	Copula             // 이다 and 아니다; 이에요, 아니에요
+)
+
+var (
+	predicatesMu sync.RWMutex
+	// Predicates which are not in the class guessed from their stem.
+	// Compounds are found by suffix; 알아듣다 by 듣다. Copula is matched
+	// only by the whole word.
+	predicates = map[string]Class{
+		// ㅎ is regular in verbs and 좋다
+		"좋다": Regular,
+		"놓다": Regular,
+		"넣다": Regular,
+		"낳다": Regular,
+		"닿다": Regular,
+		"쌓다": Regular,
+		"찧다": Regular,
+		"땋다": Regular,
+		"빻다": Regular,
+		// ㅂ is regular in these
+		"입다":  Regular,
+		"잡다":  Regular,
+		"씹다":  Regular,
+		"뽑다":  Regular,
+		"업다":  Regular,
+		"집다":  Regular,
+		"접다":  Regular,
+		"좁다":  Regular,
+		"꼽다":  Regular,
+		"수줍다": Regular,
+		"뵙다":  Regular,
+		"여쭙다": Regular,
+		// 르 drops ㅡ only; 따라요
+		"따르다":  Regular,
+		"치르다":  Regular,
+		"들르다":  Regular,
+		"우러르다": Regular,
+		"파묻다":  Regular,
+
+		"듣다":  IrregularD,
+		"걷다":  IrregularD,
+		"묻다":  IrregularD,
+		"싣다":  IrregularD,
+		"깨닫다": IrregularD,
+		"붇다":  IrregularD,
+		"일컫다": IrregularD,
+		"긷다":  IrregularD,
+		"눋다":  IrregularD,
+		"짓다":  IrregularS,
+		"잇다":  IrregularS,
+		"낫다":  IrregularS,
+		"붓다":  IrregularS,
+		"긋다":  IrregularS,
+		"젓다":  IrregularS,
+		"잣다":  IrregularS,
+		"푸르다": IrregularReo,
+		"노르다": IrregularReo,
+		"푸다":  IrregularU,
+		// Not by suffix; 모이다 is regular
+		"이다":  Copula,
+		"아니다": Copula,
+	}
+
+	// Monosyllabic stems end with ㅣ which are not contracted with 어;
+	// 피어요, not 펴요. Others are; 쳐요, 져요.
+	uncontracted = map[string]bool{
+		"피": true,
+		"비": true,
+	}
+
+	// Predicates take consonant endings only, and the ones used in their
+	// place before the others; 뵙고 but 봬요.
+	vowelStems = map[string]string{
+		"뵙다":  "뵈다",
+		"여쭙다": "여쭈다",
+	}
+)
+
+// RegisterPredicate registers conjugation class of a predicate in
+// dictionary form. Built-in ones can be overridden.
+func RegisterPredicate(verb string, c Class) {
+	predicatesMu.Lock()
+	defer predicatesMu.Unlock()
+	predicates[verb] = c
+}
+
+// ClassOf returns conjugation class of a predicate in dictionary form.
+// Registered predicates, including compounds end with them, are in their
+// class. Others are guessed from their stem; stems end with 하, ㅎ, ㅂ and
+// 르 are irregular, and the rest are regular.
+func ClassOf(verb string) Class {
+	stem, err := stemOf(verb)
+	if err != nil {
+		return Regular
+	}
+
+	predicatesMu.RLock()
+	for i := range stem {
+		if c, ok := predicates[string(stem[i:])+"다"]; ok && (c != Copula || i == 0) {
+			predicatesMu.RUnlock()
+			return c
+		}
+	}
+	predicatesMu.RUnlock()
+
+	last := stem[len(stem)-1]
+	switch {
+	case last == '하':
+		return IrregularYeo
+	case last == '르' && len(stem) > 1:
+		return IrregularReu
+	}
+	switch hangul.LastConsonant(string(stem)) {
+	case hangul.TailH:
+		return IrregularH
+	case hangul.TailB:
+		return IrregularB
+	}
+	return Regular
+}
+
+// stemOf returns stem of a predicate in dictionary form; 먹다 to 먹.
+// The stem should be made of Hangul syllables only.
+func stemOf(verb string) ([]rune, error) {
+	if !strings.HasSuffix(verb, "다") {
+		return nil, ErrNotPredicate
+	}
+	stem := []rune(strings.TrimSuffix(verb, "다"))
+	if len(stem) == 0 {
+		return nil, ErrNotPredicate
+	}
+	for _, r := range stem {
+		if _, _, _, err := hangul.SplitE(r); err != nil {
+			return nil, ErrNotPredicate
+		}
+	}
+	return stem, nil
+}
@@ -148,3 +148,65 @@ const (
 	TailH  // HANGUL JONGSEONG HIEUH
 )
 const maxTailIdx = TailH - TailG
+
+// Archaic Hangul Compatibility Jamo. Range: 3165-318E
+const (
+	_         = 0x3164 + iota
+	NN        // HANGUL LETTER SSANGNIEUN
+	ND        // HANGUL LETTER NIEUN-TIKEUT
+	NS        // HANGUL LETTER NIEUN-SIOS
+	NZ        // HANGUL LETTER NIEUN-PANSIOS
+	LGS       // HANGUL LETTER RIEUL-KIYEOK-SIOS
+	LD        // HANGUL LETTER RIEUL-TIKEUT
+	LBS       // HANGUL LETTER RIEUL-PIEUP-SIOS
+	LZ        // HANGUL LETTER RIEUL-PANSIOS
+	LQ        // HANGUL LETTER RIEUL-YEORINHIEUH
+	MB        // HANGUL LETTER MIEUM-PIEUP
+	MS        // HANGUL LETTER MIEUM-SIOS
+	MZ        // HANGUL LETTER MIEUM-PANSIOS
+	MV        // HANGUL LETTER KAPYEOUNMIEUM
+	BG        // HANGUL LETTER PIEUP-KIYEOK
+	BD        // HANGUL LETTER PIEUP-TIKEUT
+	BSG       // HANGUL LETTER PIEUP-SIOS-KIYEOK
+	BSD       // HANGUL LETTER PIEUP-SIOS-TIKEUT
+	BJ        // HANGUL LETTER PIEUP-CIEUC
+	BT        // HANGUL LETTER PIEUP-THIEUTH
+	BV        // HANGUL LETTER KAPYEOUNPIEUP
+	BBV       // HANGUL LETTER KAPYEOUNSSANGPIEUP
+	SG        // HANGUL LETTER SIOS-KIYEOK
+	SN        // HANGUL LETTER SIOS-NIEUN
+	SD        // HANGUL LETTER SIOS-TIKEUT
+	SB        // HANGUL LETTER SIOS-PIEUP
+	SJ        // HANGUL LETTER SIOS-CIEUC
+	Z         // HANGUL LETTER PANSIOS
+	ZSZS      // HANGUL LETTER SSANGIEUNG
+	YESIEUNG  // HANGUL LETTER YESIEUNG
+	YESIEUNGS // HANGUL LETTER YESIEUNG-SIOS
+	YESIEUNGZ // HANGUL LETTER YESIEUNG-PANSIOS
+	PV        // HANGUL LETTER KAPYEOUNPHIEUPH
+	HH        // HANGUL LETTER SSANGHIEUH
+	Q         // HANGUL LETTER YEORINHIEUH
+	YOYA      // HANGUL LETTER YO-YA
+	YOYAE     // HANGUL LETTER YO-YAE
+	YOI       // HANGUL LETTER YO-I
+	YUYEO     // HANGUL LETTER YU-YEO
+	YUYE      // HANGUL LETTER YU-YE
+	YUI       // HANGUL LETTER YU-I
+	ARAEA     // HANGUL LETTER ARAEA
+	ARAEAE    // HANGUL LETTER ARAEAE
+)
+
+// Archaic conjoining jamo of Hangul Jamo and Hangul Jamo Extended-A, B
+const (
+	leadFiller   = 0x115F // HANGUL CHOSEONG FILLER
+	medialFiller = 0x1160 // HANGUL JUNGSEONG FILLER
+	oldMedialEnd = 0x11A7 // HANGUL JUNGSEONG O-YAE
+	oldTailEnd   = 0x11FF // HANGUL JONGSEONG SSANGNIEUN
+
+	extALeadBase   = 0xA960 // HANGUL CHOSEONG TIKEUT-MIEUM
+	extALeadEnd    = 0xA97C // HANGUL CHOSEONG SSANGYEORINHIEUH
+	extBMedialBase = 0xD7B0 // HANGUL JUNGSEONG O-YEO
+	extBMedialEnd  = 0xD7C6 // HANGUL JUNGSEONG ARAEA-E
+	extBTailBase   = 0xD7CB // HANGUL JONGSEONG NIEUN-RIEUL
+	extBTailEnd    = 0xD7FB // HANGUL JONGSEONG PHIEUPH-THIEUTH
+)
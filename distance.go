@@ -0,0 +1,183 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import "sort"
+
+// Costs holds costs of edit operations for jamo level edit distance.
+// Lead, Medial and Tail are costs of substituting a jamo of the role,
+// and Other is of substituting runes other than Hangul.
+type Costs struct {
+	Insert, Delete            float64
+	Lead, Medial, Tail, Other float64
+}
+
+// DefaultCosts gives 1 to every edit operation
+var DefaultCosts = Costs{
+	Insert: 1, Delete: 1,
+	Lead: 1, Medial: 1, Tail: 1, Other: 1,
+}
+
+// Roles of jamo in a syllable
+const (
+	roleOther = iota
+	roleLead
+	roleMedial
+	roleTail
+)
+
+type jamoUnit struct {
+	r    rune // compatibility jamo, archaic jamo without one or others
+	role int
+}
+
+// jamoUnits decomposes s to jamo. Multi-element jamo are split to their
+// elements; 과 is ㄱ, ㅗ, ㅏ. Archaic jamo without compatibility form are
+// kept as they are; ᄓ.
+func jamoUnits(s string) []jamoUnit {
+	var us []jamoUnit
+	add := func(r rune, role int) {
+		if r == 0 {
+			return
+		}
+		c := CompatJamo(r)
+		if c == 0 {
+			us = append(us, jamoUnit{r, role})
+			return
+		}
+		for _, e := range elements(c) {
+			us = append(us, jamoUnit{e, role})
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case isSyllable(r):
+			l, m, t := SplitCompat(r)
+			add(l, roleLead)
+			add(m, roleMedial)
+			add(t, roleTail)
+		case IsJaeum(r):
+			if isOldTail(r) {
+				add(r, roleTail)
+			} else {
+				add(r, roleLead)
+			}
+		case IsMoeum(r):
+			add(r, roleMedial)
+		default:
+			us = append(us, jamoUnit{r, roleOther})
+		}
+	}
+	return us
+}
+
+func (c *Costs) substitute(a, b jamoUnit) float64 {
+	switch {
+	case a == b:
+		return 0
+	case a.role != b.role:
+		return c.Insert + c.Delete
+	case a.role == roleLead:
+		return c.Lead
+	case a.role == roleMedial:
+		return c.Medial
+	case a.role == roleTail:
+		return c.Tail
+	}
+	return c.Other
+}
+
+// Distance returns edit distance between a and b over decomposed jamo.
+func (c *Costs) Distance(a, b string) float64 {
+	return c.distance(jamoUnits(a), jamoUnits(b))
+}
+
+func (c *Costs) distance(a, b []jamoUnit) float64 {
+	prev := make([]float64, len(b)+1)
+	cur := make([]float64, len(b)+1)
+	for j := range prev {
+		prev[j] = float64(j) * c.Insert
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = float64(i) * c.Delete
+		for j := 1; j <= len(b); j++ {
+			d := prev[j-1] + c.substitute(a[i-1], b[j-1])
+			if v := prev[j] + c.Delete; v < d {
+				d = v
+			}
+			if v := cur[j-1] + c.Insert; v < d {
+				d = v
+			}
+			cur[j] = d
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// Similarity returns similarity of a and b in range of 0 to 1.
+// It is 1 if a and b are same, and 0 if there is nothing in common.
+func (c *Costs) Similarity(a, b string) float64 {
+	return c.similarity(jamoUnits(a), jamoUnits(b))
+}
+
+func (c *Costs) similarity(a, b []jamoUnit) float64 {
+	// Deleting all of a and inserting all of b is the worst
+	worst := float64(len(a))*c.Delete + float64(len(b))*c.Insert
+	if worst == 0 {
+		return 1
+	}
+	return 1 - c.distance(a, b)/worst
+}
+
+// Match is a candidate found by Nearest
+type Match struct {
+	Value      string
+	Distance   float64
+	Similarity float64
+}
+
+// Nearest returns k candidates nearest to query, in order of distance.
+// Candidates of same distance keep their order. It returns none if k is
+// not positive.
+func (c *Costs) Nearest(query string, candidates []string, k int) []Match {
+	q := jamoUnits(query)
+	ms := make([]Match, len(candidates))
+	for i, cand := range candidates {
+		u := jamoUnits(cand)
+		ms[i] = Match{
+			Value:      cand,
+			Distance:   c.distance(q, u),
+			Similarity: c.similarity(q, u),
+		}
+	}
+	sort.SliceStable(ms, func(i, j int) bool {
+		return ms[i].Distance < ms[j].Distance
+	})
+	if k < 0 {
+		k = 0
+	}
+	if k < len(ms) {
+		ms = ms[:k]
+	}
+	return ms
+}
+
+// Distance returns jamo level edit distance between a and b with
+// DefaultCosts; the distance of 강 and 간 is 1.
+func Distance(a, b string) float64 {
+	return DefaultCosts.Distance(a, b)
+}
+
+// Similarity returns similarity of a and b with DefaultCosts.
+func Similarity(a, b string) float64 {
+	return DefaultCosts.Similarity(a, b)
+}
+
+// Nearest returns k candidates nearest to query with DefaultCosts.
+func Nearest(query string, candidates []string, k int) []Match {
+	return DefaultCosts.Nearest(query, candidates, k)
+}
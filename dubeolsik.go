@@ -0,0 +1,115 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+// Keymap of Dubeolsik (2-set) keyboard layout
+var dubeolsikKeys = map[rune]rune{
+	'q': B, 'w': J, 'e': D, 'r': G, 't': S,
+	'y': YO, 'u': YEO, 'i': YA, 'o': AE, 'p': E,
+	'a': M, 's': N, 'd': ZS, 'f': L, 'g': H,
+	'h': O, 'j': EO, 'k': A, 'l': I,
+	'z': K, 'x': T, 'c': C, 'v': P,
+	'b': YU, 'n': U, 'm': EU,
+	'Q': BB, 'W': JJ, 'E': DD, 'R': GG, 'T': SS,
+	'O': YAE, 'P': YE,
+}
+
+// Multi-element jamo which can be composed by typing two keys in
+// Dubeolsik. Double consonants and ㅐ, ㅔ have their own keys.
+var dubeolsikCompounds = []rune{
+	GS, NJ, NH, LG, LM, LB, LS, LT, LP, LH, BS,
+	WA, WAE, OE, WEO, WE, WI, YI,
+}
+
+// Dubeolsik is a Hangul input automaton for Dubeolsik (2-set)
+// keyboard layout.
+type Dubeolsik struct {
+	automaton
+}
+
+// NewDubeolsik creates a new Dubeolsik input automaton.
+func NewDubeolsik() *Dubeolsik {
+	return &Dubeolsik{}
+}
+
+// Process takes a key, QWERTY letter or compatibility jamo, and updates
+// preedit and committed text. For a key which is not a Hangul key, preedit
+// is committed followed by the key itself and Process returns false.
+func (d *Dubeolsik) Process(key rune) bool {
+	j, ok := dubeolsikKeys[key]
+	if !ok && 'A' <= key && key <= 'Z' {
+		// Shift does not matter for keys without double jamo
+		j, ok = dubeolsikKeys[key-'A'+'a']
+	}
+	if !ok && ((G <= key && key <= H) || (A <= key && key <= I)) {
+		j, ok = key, true
+	}
+	if !ok {
+		d.commitWith(key)
+		return false
+	}
+
+	if IsJaeum(j) {
+		d.processConsonant(j)
+	} else {
+		d.processVowel(j)
+	}
+	return true
+}
+
+func (d *Dubeolsik) processConsonant(j rune) {
+	s := d.cur
+	switch {
+	case s.empty():
+		d.push(syllableState{l: j})
+	case s.l == 0 || s.m == 0:
+		d.startWith(syllableState{l: j})
+	case s.t == 0:
+		if Tail(j) == 0 {
+			d.startWith(syllableState{l: j})
+			return
+		}
+		s.t = j
+		d.push(s)
+	default:
+		if c := combineJamo(s.t, j, dubeolsikCompounds); c != 0 {
+			s.t = c
+			d.push(s)
+			return
+		}
+		d.startWith(syllableState{l: j})
+	}
+}
+
+func (d *Dubeolsik) processVowel(j rune) {
+	s := d.cur
+	switch {
+	case s.empty():
+		d.push(syllableState{m: j})
+	case s.m == 0:
+		s.m = j
+		d.push(s)
+	case s.t == 0:
+		if c := combineJamo(s.m, j, dubeolsikCompounds); c != 0 {
+			s.m = c
+			d.push(s)
+			return
+		}
+		d.startWith(syllableState{m: j})
+	default:
+		// Move the tail consonant to the next syllable; 갑 + ㅏ = 가바.
+		// For a double tail, only its last element moves; 값 + ㅏ = 갑사.
+		var moved rune
+		if es, ok := SplitMultiElement(s.t); ok && Lead(s.t) == 0 {
+			s.t, moved = es[0], es[1]
+		} else {
+			s.t, moved = 0, s.t
+		}
+		d.cur = s
+		d.commit()
+		d.push(syllableState{l: moved})
+		d.push(syllableState{l: moved, m: j})
+	}
+}
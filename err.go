@@ -0,0 +1,21 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import "errors"
+
+// ErrUnknownLayout show the keyboard layout is not registered
+var ErrUnknownLayout = errors.New("unknown keyboard layout")
+
+// Errors of SplitE and JoinE
+var (
+	ErrNotSyllable   = errors.New("not a hangul syllable")
+	ErrInvalidLead   = errors.New("invalid lead consonant")
+	ErrInvalidMedial = errors.New("invalid medial vowel")
+	ErrInvalidTail   = errors.New("invalid tail consonant")
+)
+
+// ErrNotJamo show the text is not a Hangul jamo
+var ErrNotJamo = errors.New("not a hangul jamo")
@@ -1,6 +1,7 @@
 package hangul_test
 
 import (
+	"encoding/json"
 	"fmt"
 
 	hangul "github.com/suapapa/go_hangul"
@@ -27,3 +28,30 @@ func ExampleLastConsonant() {
 	// 4540
 	// 0
 }
+
+func ExampleJosa() {
+	fmt.Println(hangul.Josa("사과", "을/를"))
+	fmt.Println(hangul.Josa("책", "(이)가"))
+	fmt.Println(hangul.Josa("서울", "(으)로"))
+	fmt.Println(hangul.Josa("3", "은(는)"))
+	fmt.Println(hangul.Josa("Apple", "이"))
+	// Output:
+	// 사과를
+	// 책이
+	// 서울로
+	// 3은
+	// Apple이
+}
+
+func ExampleSyllable() {
+	s, _ := hangul.SyllableOf('한')
+	fmt.Println(s.Lead.Compat(), s.Medial.Compat(), s.Tail.Compat())
+	fmt.Println(s.LeadIndex(), s.MedialIndex(), s.TailIndex())
+
+	b, _ := json.Marshal([]hangul.Syllable{s})
+	fmt.Println(string(b))
+	// Output:
+	// ㅎ ㅏ ㄴ
+	// 18 0 4
+	// ["한"]
+}
@@ -0,0 +1,184 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+// Format substitutes placeholders, like {name}, in template with args
+// and resolves the postposition(조사) marker immediately following each
+// placeholder; "{name}(이)가 {item}을/를 샀다". Markers can be written as
+// "(이)가", "을/를" or "은(는)". A marker can also be a placeholder itself
+// to be resolved by the preceding text; "3{을/를}" to "3을". Unknown
+// placeholders and markers are left intact.
+func Format(template string, args map[string]string) string {
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(template, '{')
+		if i < 0 {
+			break
+		}
+		j := strings.IndexByte(template[i:], '}')
+		if j < 0 {
+			break
+		}
+		j += i
+
+		b.WriteString(template[:i])
+		key := template[i+1 : j]
+		v, ok := args[key]
+		if !ok {
+			if _, _, ok := findJosa(key); ok {
+				// "3{을/를}"; resolve by preceding text
+				b.WriteString(SelectJosa(b.String(), key))
+			} else {
+				b.WriteString(template[i : j+1])
+			}
+			template = template[j+1:]
+			continue
+		}
+		b.WriteString(v)
+		template = template[j+1:]
+		if josa, n, ok := parseJosaMarker(template); ok {
+			b.WriteString(SelectJosa(v, josa))
+			template = template[n:]
+		}
+	}
+	b.WriteString(template)
+	return b.String()
+}
+
+// Sprintf formats as fmt.Sprintf does and resolves the postposition(조사)
+// marker immediately following each verb; Sprintf("%s(이)가", "책").
+// Verbs with explicit argument indexes or '*' are not supported. Missing
+// and extra arguments are reported as fmt.Sprintf does.
+func Sprintf(format string, a ...interface{}) string {
+	var b strings.Builder
+	for len(format) > 0 {
+		i := strings.IndexByte(format, '%')
+		if i < 0 {
+			break
+		}
+		b.WriteString(format[:i])
+		format = format[i:]
+
+		n := verbLen(format)
+		if format[:n] == "%%" || len(a) == 0 {
+			b.WriteString(fmt.Sprintf(format[:n]))
+			format = format[n:]
+			continue
+		}
+		v := fmt.Sprintf(format[:n], a[0])
+		a = a[1:]
+		b.WriteString(v)
+		format = format[n:]
+		if josa, n, ok := parseJosaMarker(format); ok {
+			b.WriteString(SelectJosa(v, josa))
+			format = format[n:]
+		}
+	}
+	b.WriteString(format)
+	if len(a) > 0 {
+		// As fmt.Sprintf reports; %!(EXTRA int=3, string=x)
+		b.WriteString("%!(EXTRA ")
+		for i, arg := range a {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			if arg == nil {
+				b.WriteString("<nil>")
+				continue
+			}
+			fmt.Fprintf(&b, "%T=%v", arg, arg)
+		}
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// verbLen returns byte length of the verb at the head of format
+func verbLen(format string) int {
+	n := 1
+	for n < len(format) && strings.IndexByte("+-# 0123456789.", format[n]) >= 0 {
+		n++
+	}
+	if n < len(format) {
+		_, size := utf8.DecodeRuneInString(format[n:])
+		n += size
+	}
+	return n
+}
+
+// TemplateFuncs are functions for text/template and html/template;
+// {{josa .Name "(이)가"}}.
+var TemplateFuncs = template.FuncMap{
+	"josa": Josa,
+}
+
+// hangulLen returns byte length of leading Hangul syllables of s
+func hangulLen(s string) int {
+	n := 0
+	for _, r := range s {
+		if !isSyllable(r) {
+			break
+		}
+		n += utf8.RuneLen(r)
+	}
+	return n
+}
+
+// parseJosaMarker parses a postposition marker at the head of s.
+// It returns the marker and its byte length if it is a known one.
+func parseJosaMarker(s string) (string, int, bool) {
+	var head, tail string
+	switch {
+	case strings.HasPrefix(s, "("):
+		// (이)가
+		n := hangulLen(s[1:])
+		if n == 0 || !strings.HasPrefix(s[1+n:], ")") {
+			return "", 0, false
+		}
+		head = s[:n+2]
+		tail = s[n+2:]
+	default:
+		n := hangulLen(s)
+		if n == 0 {
+			return "", 0, false
+		}
+		switch {
+		case strings.HasPrefix(s[n:], "/"):
+			// 을/를
+			head = s[:n+1]
+			tail = s[n+1:]
+		case strings.HasPrefix(s[n:], "("):
+			// 은(는)
+			m := hangulLen(s[n+1:])
+			if m == 0 || !strings.HasPrefix(s[n+1+m:], ")") {
+				return "", 0, false
+			}
+			marker := s[:n+m+2]
+			if _, _, ok := findJosa(marker); ok {
+				return marker, len(marker), true
+			}
+			return "", 0, false
+		default:
+			return "", 0, false
+		}
+	}
+
+	// Try longest one first; "(으)로부터" before "(으)로"
+	rs := []rune(tail[:hangulLen(tail)])
+	for i := len(rs); i > 0; i-- {
+		marker := head + string(rs[:i])
+		if _, _, ok := findJosa(marker); ok {
+			return marker, len(marker), true
+		}
+	}
+	return "", 0, false
+}
@@ -0,0 +1,105 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/suapapa/go_hangul/hanja"
+)
+
+// OtherBucket is the label of bucket for strings which do not start with
+// Hangul or Latin letters; digits, symbols and so on.
+const OtherBucket = "#"
+
+// Bucket is a group of strings under an index header, like ㄱ or A.
+type Bucket struct {
+	Label string
+	Items []string
+}
+
+// Grouper groups strings by initial consonant(초성) for index headers of
+// address books or dictionaries; ㄱ, ㄴ, ㄷ...
+type Grouper struct {
+	// SeparateDouble puts strings start with double consonants in their
+	// own buckets; ㄲ for 까치. Otherwise they are put under their base
+	// consonant; ㄱ for 까치.
+	SeparateDouble bool
+	// NoLatin puts strings start with Latin letters in OtherBucket.
+	// Otherwise they are grouped in buckets from A to Z.
+	NoLatin bool
+}
+
+// Group groups ss in buckets. Buckets are in order of Hangul, Latin
+// letters and OtherBucket, and empty ones are omitted. Strings in a bucket
+// keep their order in ss. Hanja is grouped by its Hangul reading; 金 under
+// ㄱ.
+func (g *Grouper) Group(ss []string) []Bucket {
+	items := make(map[string][]string)
+	for _, s := range ss {
+		label := g.Label(s)
+		items[label] = append(items[label], s)
+	}
+
+	var labels []string
+	for l := rune(LeadG); l <= LeadH; l++ {
+		labels = append(labels, string(CompatJamo(l)))
+	}
+	for c := 'A'; c <= 'Z'; c++ {
+		labels = append(labels, string(c))
+	}
+	labels = append(labels, OtherBucket)
+
+	var bs []Bucket
+	for _, l := range labels {
+		if len(items[l]) > 0 {
+			bs = append(bs, Bucket{l, items[l]})
+		}
+	}
+	return bs
+}
+
+// Label returns label of the bucket for s.
+func (g *Grouper) Label(s string) string {
+	r, _ := utf8.DecodeRuneInString(s)
+	if hanja.IsHanja(r) {
+		r, _ = utf8.DecodeRuneInString(hanja.Convert(string(r)))
+	}
+
+	switch {
+	case isSyllable(r):
+		l, _, _ := Split(r)
+		r = CompatJamo(l)
+	case IsJaeum(r) || ToFullwidth(r) != 0:
+		r = CompatJamo(r)
+	case 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z':
+		if g.NoLatin {
+			return OtherBucket
+		}
+		return string(unicode.ToUpper(r))
+	default:
+		return OtherBucket
+	}
+
+	// Clusters, like ㄳ or ㅴ, under their first element
+	if es, ok := SplitMultiElement(r); ok && (Lead(r) == 0 || !IsLead(Lead(r))) {
+		r = es[0]
+	}
+	if es, ok := SplitMultiElement(r); ok && !g.SeparateDouble {
+		r = es[0] // ㄲ under ㄱ
+	}
+	if !IsLead(Lead(r)) {
+		return OtherBucket
+	}
+	return string(r)
+}
+
+// GroupByChosung groups ss by initial consonant, with double consonants
+// under their base. See Grouper.
+func GroupByChosung(ss []string) []Bucket {
+	var g Grouper
+	return g.Group(ss)
+}
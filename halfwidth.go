@@ -0,0 +1,144 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import (
+	"io"
+
+	"golang.org/x/text/transform"
+)
+
+// Halfwidth Hangul jamo; U+FFA0-FFDC. Vowels are laid out in four rows
+// with gaps between them.
+const (
+	halfwidthFiller = 0xFFA0 // HALFWIDTH HANGUL FILLER
+	halfwidthG      = 0xFFA1 // HALFWIDTH HANGUL LETTER KIYEOK
+	halfwidthH      = 0xFFBE // HALFWIDTH HANGUL LETTER HIEUH
+	compatFiller    = 0x3164 // HANGUL FILLER
+)
+
+// Rows of halfwidth vowels and their first compatibility jamo
+var halfwidthVowels = []struct {
+	first, last, compat rune
+}{
+	{0xFFC2, 0xFFC7, A},   // ㅏ-ㅔ
+	{0xFFCA, 0xFFCF, YEO}, // ㅕ-ㅚ
+	{0xFFD2, 0xFFD7, YO},  // ㅛ-ㅠ
+	{0xFFDA, 0xFFDC, EU},  // ㅡ-ㅣ
+}
+
+// ToHalfwidth converts compatibility jamo to halfwidth jamo.
+// It returns 0 if r is not a modern compatibility jamo.
+func ToHalfwidth(r rune) rune {
+	switch {
+	case r == compatFiller:
+		return halfwidthFiller
+	case G <= r && r <= H:
+		return r - G + halfwidthG
+	}
+	for _, v := range halfwidthVowels {
+		if v.compat <= r && r <= v.compat+(v.last-v.first) {
+			return r - v.compat + v.first
+		}
+	}
+	return 0
+}
+
+// ToFullwidth converts halfwidth jamo to compatibility jamo.
+// It returns 0 if r is not a halfwidth jamo.
+func ToFullwidth(r rune) rune {
+	switch {
+	case r == halfwidthFiller:
+		return compatFiller
+	case halfwidthG <= r && r <= halfwidthH:
+		return r - halfwidthG + G
+	}
+	for _, v := range halfwidthVowels {
+		if v.first <= r && r <= v.last {
+			return r - v.first + v.compat
+		}
+	}
+	return 0
+}
+
+// FromHalfwidth converts halfwidth jamo in s to precomposed syllables, or
+// to compatibility jamo if they do not make a syllable; "ﾡ﾿､" to "각".
+// Other text is left untouched.
+func FromHalfwidth(s string) string {
+	out, _, _ := transform.String(NewHalfwidthComposer(), s)
+	return out
+}
+
+// NewHalfwidthReader creates io.Reader which converts halfwidth jamo read
+// from r to precomposed syllables.
+func NewHalfwidthReader(r io.Reader) io.Reader {
+	return transform.NewReader(r, NewHalfwidthComposer())
+}
+
+// NewHalfwidthComposer returns a transform.Transformer which converts
+// halfwidth jamo to precomposed syllables. Jamo are composed as they are
+// typed on Dubeolsik keyboard; a tail consonant moves to the next
+// syllable if a vowel follows.
+func NewHalfwidthComposer() transform.Transformer {
+	return &halfwidthComposer{}
+}
+
+type halfwidthComposer struct {
+	ime     Dubeolsik
+	pending []byte // output waiting for room in dst
+}
+
+// Reset implements transform.Transformer
+func (c *halfwidthComposer) Reset() {
+	c.ime.Reset()
+	c.pending = nil
+}
+
+// takeCommitted moves committed text of the automaton to pending
+func (c *halfwidthComposer) takeCommitted() {
+	for _, r := range c.ime.committed {
+		c.pending = append(c.pending, string(r)...)
+	}
+	c.ime.committed = c.ime.committed[:0]
+}
+
+// Transform implements transform.Transformer
+func (c *halfwidthComposer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for {
+		n := copy(dst[nDst:], c.pending)
+		nDst += n
+		c.pending = c.pending[n:]
+		if len(c.pending) > 0 {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+
+		if nSrc >= len(src) {
+			if atEOF && !c.ime.cur.empty() {
+				c.ime.commit()
+				c.takeCommitted()
+				continue
+			}
+			return nDst, nSrc, nil
+		}
+
+		r, n, short := nextRune(src[nSrc:], atEOF)
+		if short {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		if j := ToFullwidth(r); j != 0 && j != compatFiller {
+			c.ime.Process(j)
+			c.takeCommitted()
+			nSrc += n
+			continue
+		}
+
+		// Other runes end the syllable being composed
+		c.ime.commit()
+		c.takeCommitted()
+		c.pending = append(c.pending, src[nSrc:nSrc+n]...)
+		nSrc += n
+	}
+}
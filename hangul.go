@@ -8,45 +8,64 @@
 //    - Split a character to it's three elements
 //    - Split multi element
 //    - Stroke count
+//    - Compose and decompose Hangul in strings and streams
+//    - Input automaton for Dubeolsik and Sebeolsik keyboard layouts
+//    - Classify characters across all Hangul blocks
+//    - Old Hangul jamo and syllable blocks
+//    - Convert halfwidth jamo
+//    - Iterate and truncate by user-perceived syllables
+//    - Group strings by initial consonants for index headers
+//    - Syllable and Jamo value types
+//    - Phonological features of jamo
 package hangul
 
-// IsHangul checks given rune is Hangul
-func IsHangul(r rune) bool {
-	switch {
-	case 0xAC00 <= r && r <= 0xD7A3:
-		return true
-	case IsJaeum(r):
-		return true
-	case IsMoeum(r):
-		return true
-	}
+import "unicode/utf8"
 
-	return false
+// IsHangul checks given rune is Hangul; syllables, jamo of any form,
+// and enclosed Hangul. See Classify for the details.
+func IsHangul(r rune) bool {
+	return Classify(r) != NotHangul
 }
 
-// Join converts NFD to NFC
+// Join converts NFD to NFC. It returns U+FFFD if l, m and t can not
+// make a syllable. See JoinE for the reason.
 func Join(l, m, t rune) rune {
+	r, err := JoinE(l, m, t)
+	if err != nil {
+		return rune(0xFFFD)
+	}
+	return r
+}
+
+// JoinE is like Join but returns ErrInvalidLead, ErrInvalidMedial or
+// ErrInvalidTail if the jamo can not be a part of modern syllable. Jamo
+// can be in conjoining, compatibility or halfwidth form, and t can be 0.
+func JoinE(l, m, t rune) (rune, error) {
 	// Convert if given rune is compatibility jamo
 	li, ok := leadIdx(Lead(l))
 	if !ok {
-		return rune(0xFFFD)
+		return 0, ErrInvalidLead
 	}
 
 	mi, ok := medialIdx(Medial(m))
 	if !ok {
-		return rune(0xFFFD)
+		return 0, ErrInvalidMedial
 	}
 
 	ti, ok := tailIdx(Tail(t))
-	if !ok {
-		return rune(0xFFFD)
+	if !ok || t != 0 && ti == 0 {
+		return 0, ErrInvalidTail
 	}
 
-	return rune(0xAC00 + (li*21+mi)*28 + ti)
+	return rune(0xAC00 + (li*21+mi)*28 + ti), nil
 }
 
-// Split converts NFC to NFD
+// Split converts NFC to NFD. It returns 0s if c is not a Hangul syllable.
 func Split(c rune) (l, m, t rune) {
+	if !isSyllable(c) {
+		return 0, 0, 0
+	}
+
 	t = (c - 0xAC00) % 28
 	m = ((c - 0xAC00 - t) % 588) / 28
 	l = (c - 0xAC00) / 588
@@ -60,6 +79,16 @@ func Split(c rune) (l, m, t rune) {
 	return
 }
 
+// SplitE is like Split but returns ErrNotSyllable if c is not a Hangul
+// syllable.
+func SplitE(c rune) (l, m, t rune, err error) {
+	if !isSyllable(c) {
+		return 0, 0, 0, ErrNotSyllable
+	}
+	l, m, t = Split(c)
+	return l, m, t, nil
+}
+
 // SplitCompat splits and returns l, m, t in compatibility jamo
 func SplitCompat(c rune) (l, m, t rune) {
 	l, m, t = Split(c)
@@ -80,13 +109,11 @@ func EndsWithConsonant(word string) bool {
 }
 
 // LastConsonant returns last consonant(종성).
-// It returns 0 if last consonant not exists.
+// It returns 0 if last consonant not exists, or word does not end with
+// a Hangul syllable.
 func LastConsonant(word string) rune {
-	if word == "" {
-		return 0
-	}
-	runes := []rune(word)
-	_, _, t := Split(runes[len(runes)-1])
+	r, _ := utf8.DecodeLastRuneInString(word)
+	_, _, t := Split(r)
 	return t
 }
 
@@ -4,7 +4,19 @@
 
 package hangul
 
-import "testing"
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"testing/quick"
+	"text/template"
+	"unicode"
+)
 
 func TestIdx(t *testing.T) {
 	if li, ok := leadIdx(Lead(T)); !ok || li != 16 {
@@ -205,3 +217,1624 @@ func TestAppendPostposition(t *testing.T) {
 		}
 	}
 }
+
+func TestComposeString(t *testing.T) {
+	cases := []struct {
+		in, out string
+	}{
+		{"", ""},
+		{"abc", "abc"},
+		{"\u1112\u1161\u11ab\u1100\u1173\u11af", "한글"},
+		{"\u1109\u1165 \u110b\u116e\u11af", "서 울"},
+		{"가\u11a8", "각"},
+		{"\u1100x\u1161", "\u1100x\u1161"},
+		{"\u11a8\u1161", "\u11a8\u1161"},
+	}
+	for _, c := range cases {
+		if out := ComposeString(c.in); out != c.out {
+			t.Errorf("ComposeString(%q) = %q, want %q", c.in, out, c.out)
+		}
+	}
+}
+
+func TestDecomposeString(t *testing.T) {
+	for r := rune(0xAC00); r <= 0xD7A3; r++ {
+		s := string(r)
+		d := DecomposeString(s)
+		if d == s {
+			t.Fatalf("%c is not decomposed", r)
+		}
+		if c := ComposeString(d); c != s {
+			t.Fatalf("round trip of %c failed: got %q", r, c)
+		}
+	}
+	if d := DecomposeString("a한b"); d != "a\u1112\u1161\u11abb" {
+		t.Errorf("unexpected %q", d)
+	}
+}
+
+func TestComposeReader(t *testing.T) {
+	in := strings.Repeat("한글 ", 1000)
+	r := NewComposeReader(iotest.OneByteReader(strings.NewReader(DecomposeString(in))))
+	out, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != in {
+		t.Errorf("unexpected output from compose reader")
+	}
+
+	r = NewDecomposeReader(iotest.OneByteReader(strings.NewReader("한글")))
+	out, err = ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "\u1112\u1161\u11ab\u1100\u1173\u11af" {
+		t.Errorf("unexpected output %q from decompose reader", out)
+	}
+}
+
+func TestDubeolsik(t *testing.T) {
+	cases := []struct {
+		keys, out string
+	}{
+		{"dkssudgktpdy", "안녕하세요"},
+		{"rkqt", "값"},
+		{"rkqtk", "갑사"},
+		{"rkqk", "가바"},
+		{"RkTk", "까싸"},
+		{"RkTdk", "깠아"},
+		{"dhkd", "왕"},
+		{"dho", "왜"},
+		{"dnp", "웨"},
+		{"dhkl", "와ㅣ"}, // ㅘ and ㅣ do not make ㅙ
+		{"dnjl", "워ㅣ"}, // ㅝ and ㅣ do not make ㅞ
+		{"ghkdhoakfsfk", "화왜말ㄴ라"},
+		{"dkfrh", "알고"},
+		{"gks rmf!", "한 글!"},
+		{"rr", "ㄱㄱ"},
+		{"kk", "ㅏㅏ"},
+	}
+	for _, c := range cases {
+		d := NewDubeolsik()
+		for _, k := range c.keys {
+			d.Process(k)
+		}
+		if out := d.Flush(); out != c.out {
+			t.Errorf("%s: expected %s, got %s", c.keys, c.out, out)
+		}
+	}
+}
+
+func TestDubeolsikBackspace(t *testing.T) {
+	d := NewDubeolsik()
+	for _, k := range "rkqt" {
+		d.Process(k)
+	}
+	exps := []string{"갑", "가", "ㄱ", ""}
+	for _, exp := range exps {
+		if !d.Backspace() {
+			t.Fatalf("backspace failed before %q", exp)
+		}
+		if p := d.Preedit(); p != exp {
+			t.Errorf("expected preedit %q, got %q", exp, p)
+		}
+	}
+	if d.Backspace() {
+		t.Errorf("backspace on empty preedit should be false")
+	}
+
+	d.Process(G)
+	d.Process(A)
+	d.Process(B)
+	d.Process(A)
+	if d.Committed() != "가" || d.Preedit() != "바" {
+		t.Errorf("got %q + %q", d.Committed(), d.Preedit())
+	}
+	d.Backspace()
+	if d.Committed() != "가" || d.Preedit() != "ㅂ" {
+		t.Errorf("got %q + %q", d.Committed(), d.Preedit())
+	}
+}
+
+func TestSebeolsik(t *testing.T) {
+	cases := []struct {
+		layout   string
+		moachigi bool
+		keys     string
+		out      string
+	}{
+		{Sebeolsik390, false, "jfsheamfncj4", "안녕하세요"},
+		{SebeolsikFinal, false, "jfsheamfncj4", "안녕하세요"},
+		{Sebeolsik390, false, "kkf", "까"},
+		{Sebeolsik390, false, "jvf", "와"},
+		{Sebeolsik390, false, "kfxq", "갃"},
+		{Sebeolsik390, false, "fjs", "ㅏㅇㄴ"},
+		{Sebeolsik390, true, "fjs", "안"},
+		{Sebeolsik390, true, "sfjhae", "안녕"},
+		{Sebeolsik390, true, "kfxkd", "각기"},
+		{Sebeolsik390, true, "kfxkf", "각가"},
+		{Sebeolsik390, true, "kkfjvf", "까와"},
+		{Sebeolsik390, true, "fkxx", "갂"},
+		{Sebeolsik390, false, "kfxkd", "각기"},
+		{Sebeolsik390, false, "kfkk", "가ㄲ"},
+		{SebeolsikFinal, false, "kfV", "갃"},
+		{Sebeolsik390, false, "kf M", "가 1"},
+	}
+	for _, c := range cases {
+		s, err := NewSebeolsik(c.layout, c.moachigi)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, k := range c.keys {
+			s.Process(k)
+		}
+		if out := s.Flush(); out != c.out {
+			t.Errorf("%s(%v) %s: expected %s, got %s",
+				c.layout, c.moachigi, c.keys, c.out, out)
+		}
+	}
+
+	if _, err := NewSebeolsik("unknown", false); err != ErrUnknownLayout {
+		t.Errorf("expected ErrUnknownLayout, got %v", err)
+	}
+}
+
+func TestRegisterSebeolsikLayout(t *testing.T) {
+	RegisterSebeolsikLayout("test", SebeolsikLayout{
+		'a': LeadG, 'b': MedialA, 'c': TailN,
+	})
+	s, err := NewSebeolsik("test", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var im InputMethod = s
+	for _, k := range "abc" {
+		im.Process(k)
+	}
+	if p := im.Preedit(); p != "간" {
+		t.Errorf("expected 간, got %s", p)
+	}
+	im.Backspace()
+	if p := im.Preedit(); p != "가" {
+		t.Errorf("expected 가, got %s", p)
+	}
+}
+
+func TestQwerty(t *testing.T) {
+	cases := []struct {
+		hangul, qwerty string
+	}{
+		{"안녕하세요", "dkssudgktpdy"},
+		{"과", "rhk"},
+		{"닭", "ekfr"},
+		{"꽃", "Rhc"},
+		{"ㅗ디ㅣㅐ", "hello"},
+		{"한글 123", "gksrmf 123"},
+	}
+	for _, c := range cases {
+		if q := ToQwerty(c.hangul); q != c.qwerty {
+			t.Errorf("ToQwerty(%s): expected %s, got %s", c.hangul, c.qwerty, q)
+		}
+		if h := FromQwerty(c.qwerty); h != c.hangul {
+			t.Errorf("FromQwerty(%s): expected %s, got %s", c.qwerty, c.hangul, h)
+		}
+	}
+
+	// Archaic jamo without keys are kept
+	archaic := []struct {
+		hangul, qwerty string
+	}{
+		{"ㆍ가ㅿᅀ", "ㆍrkㅿᅀ"},
+		{"ㅥ", "ss"},
+		{"ㅭ", "ㅭ"}, // ㄹ and ㅿ
+	}
+	for _, c := range archaic {
+		if q := ToQwerty(c.hangul); q != c.qwerty {
+			t.Errorf("ToQwerty(%s): expected %s, got %s", c.hangul, c.qwerty, q)
+		}
+	}
+}
+
+func TestLooksMistyped(t *testing.T) {
+	cases := []struct {
+		s        string
+		mistyped bool
+	}{
+		{"", false},
+		{"dkssud", true},
+		{"dkssud gktpdy", true},
+		{"hello", false},
+		{"ㅗ디ㅣㅐ", true},
+		{"안녕", false},
+		{"ㅋㅋㅋ", false},
+		{"ㅠㅠ", false},
+		{"gks123", false},
+	}
+	for _, c := range cases {
+		if LooksMistyped(c.s) != c.mistyped {
+			t.Errorf("LooksMistyped(%q) should be %v", c.s, c.mistyped)
+		}
+	}
+}
+
+func TestChosung(t *testing.T) {
+	if c := Chosung("광화문 Gate"); c != "ㄱㅎㅁ Gate" {
+		t.Errorf("unexpected %s", c)
+	}
+	if c := Chosung("ㄱ까"); c != "ㄱㄲ" {
+		t.Errorf("unexpected %s", c)
+	}
+}
+
+func TestIndexChosung(t *testing.T) {
+	cases := []struct {
+		s, query string
+		i, n     int
+	}{
+		{"광화문", "ㄱㅎ", 0, 6},
+		{"광화문", "광ㅎ", 0, 6},
+		{"광화문", "ㅎㅁ", 3, 6},
+		{"강호", "ㄱㅎ", 0, 6},
+		{"광화문", "강ㅎ", -1, 0},
+		{"광화문", "ㄲ", -1, 0},
+		{"서울 광화문", "ㄱㅎㅁ", 7, 9},
+		{"광화문", "", 0, 0},
+	}
+	for _, c := range cases {
+		i, n := IndexChosung(c.s, c.query)
+		if i != c.i || n != c.n {
+			t.Errorf("IndexChosung(%s, %s): expected %d, %d got %d, %d",
+				c.s, c.query, c.i, c.n, i, n)
+		}
+	}
+	if !ContainsChosung("서울 광화문", "ㅎㅁ") {
+		t.Errorf("ㅎㅁ should be in 서울 광화문")
+	}
+}
+
+func TestChosungIndex(t *testing.T) {
+	idx := NewChosungIndex([]string{"광화문", "강호", "경복궁", "서울역"})
+	idx.Add("광화문광장")
+
+	res := idx.Search("ㄱㅎ")
+	if len(res) != 3 {
+		t.Fatalf("unexpected result %v", res)
+	}
+	res = idx.Search("광ㅎ")
+	if len(res) != 2 || res[0] != "광화문" || res[1] != "광화문광장" {
+		t.Errorf("unexpected result %v", res)
+	}
+	if res = idx.Search("ㅅㅇ역"); len(res) != 1 {
+		t.Errorf("unexpected result %v", res)
+	}
+	if res = idx.Search("ㅎ"); len(res) != 0 {
+		t.Errorf("unexpected result %v", res)
+	}
+	if idx.Len() != 5 {
+		t.Errorf("unexpected length %d", idx.Len())
+	}
+}
+
+func TestHasIncrementalPrefix(t *testing.T) {
+	cases := []struct {
+		s, query string
+		match    bool
+	}{
+		{"가방", "", true},
+		{"가방", "갑", true},
+		{"가방", "가", true},
+		{"갈비", "가", true},
+		{"갈비", "ㄱ", true},
+		{"갈비", "갈", true},
+		{"갈비", "갑", false},
+		{"달걀", "닭", true},
+		{"닭고기", "닭", true},
+		{"닭고기", "달", true},
+		{"달걀", "닯", false},
+		{"과자", "고", true},
+		{"개미", "가", false},
+		{"가방", "가바", true},
+		{"가방", "가방가", false},
+		{"가", "갑", false},
+		{"까치", "ㄱ", false},
+	}
+	for _, c := range cases {
+		if HasIncrementalPrefix(c.s, c.query) != c.match {
+			t.Errorf("HasIncrementalPrefix(%s, %s) should be %v",
+				c.s, c.query, c.match)
+		}
+	}
+}
+
+func TestSearchIncremental(t *testing.T) {
+	idx := NewChosungIndex([]string{"가방", "갈비", "달걀", "가게"})
+	if res := idx.SearchIncremental("갑"); len(res) != 1 || res[0] != "가방" {
+		t.Errorf("unexpected result %v", res)
+	}
+	if res := idx.SearchIncremental("가"); len(res) != 3 {
+		t.Errorf("unexpected result %v", res)
+	}
+	if res := idx.SearchIncremental("닭"); len(res) != 1 {
+		t.Errorf("unexpected result %v", res)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		d    float64
+	}{
+		{"", "", 0},
+		{"강", "강", 0},
+		{"강", "간", 1},
+		{"강", "", 3},
+		{"과", "고", 1},
+		{"닭", "달", 1},
+		{"까", "가", 1},
+		{"한국", "한극", 1},
+		{"abc", "abd", 1},
+		{"ᄓᅡ", "ꥠᅡ", 1}, // archaic jamo without compatibility form
+		{"가ퟋ", "가ퟌ", 1},
+	}
+	for _, c := range cases {
+		if d := Distance(c.a, c.b); d != c.d {
+			t.Errorf("Distance(%s, %s): expected %v, got %v", c.a, c.b, c.d, d)
+		}
+	}
+
+	costs := DefaultCosts
+	costs.Tail = 0.5
+	if d := costs.Distance("강", "간"); d != 0.5 {
+		t.Errorf("unexpected distance %v", d)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	if s := Similarity("한글", "한글"); s != 1 {
+		t.Errorf("unexpected similarity %v", s)
+	}
+	if s := Similarity("", ""); s != 1 {
+		t.Errorf("unexpected similarity %v", s)
+	}
+	if s := Similarity("가", "ab"); s != 0 {
+		t.Errorf("unexpected similarity %v", s)
+	}
+	if Similarity("강", "간") <= Similarity("강", "눈") {
+		t.Errorf("강 should be similar to 간 more than 눈")
+	}
+}
+
+func TestNearest(t *testing.T) {
+	ms := Nearest("한굴", []string{"한강", "한글", "하늘", "한국"}, 2)
+	if len(ms) != 2 || ms[0].Value != "한글" || ms[1].Value != "한국" {
+		t.Errorf("unexpected matches %v", ms)
+	}
+	if ms := Nearest("한굴", []string{"한강", "한글"}, -1); len(ms) != 0 {
+		t.Errorf("k = -1: expected no match, got %v", ms)
+	}
+	if ms := Nearest("한굴", []string{"한강", "한글"}, 5); len(ms) != 2 {
+		t.Errorf("k = 5: expected all matches, got %v", ms)
+	}
+}
+
+func TestJosa(t *testing.T) {
+	cases := []struct {
+		word, josa, expected string
+	}{
+		{"사과", "을/를", "사과를"},
+		{"책", "(이)가", "책이"},
+		{"친구", "(이)가", "친구가"},
+		{"친구", "은(는)", "친구는"},
+		{"서울", "(으)로", "서울로"},
+		{"부산", "로", "부산으로"},
+		{"영철", "랑", "영철이랑"},
+		{"영희", "아", "영희야"},
+		{"철수", "이에요", "철수예요"},
+		{"책", "예요", "책이에요"},
+		{"너", "이었", "너였"},
+		{"물", "과", "물과"},
+		{"바다", "과", "바다와"},
+		{"3", "을/를", "3을"},
+		{"2", "을/를", "2를"},
+		{"10", "이", "10이"},
+		{"1000", "로", "1000으로"},
+		{"0", "은", "0은"},
+		{"3.5", "가", "3.5가"},
+		{"100", "를", "100을"},
+		{"1020", "가", "1020이"},
+		{"10000", "로", "10000으로"},
+		{"200000000", "가", "200000000이"},
+		{"3.14", "를", "3.14를"},
+		{"2024", "은", "2024는"},
+		{"Apple", "은", "Apple은"},
+		{"Apple", "로", "Apple로"},
+		{"Google", "이", "Google이"},
+		{"computer", "가", "computer가"},
+		{"book", "을", "book을"},
+		{"IBM", "이", "IBM이"},
+		{"KBS", "가", "KBS가"},
+		{"山", "이", "山이"},
+		{"海", "이", "海가"},
+		{"ㄹ", "으로", "ㄹ로"},
+		{"집", "에서", "집에서"},
+		{"", "이", "가"},
+	}
+	for _, c := range cases {
+		if actual := Josa(c.word, c.josa); actual != c.expected {
+			t.Errorf("Josa(%s, %s): expected %s, got %s",
+				c.word, c.josa, c.expected, actual)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	args := map[string]string{"name": "철수", "item": "책", "city": "서울", "n": "3"}
+	cases := []struct {
+		template, expected string
+	}{
+		{"{name}(이)가 {item}(을)를 구매했습니다", "철수가 책을 구매했습니다"},
+		{"{item}이/가 {name}을/를 샀다", "책이 철수를 샀다"},
+		{"{name}은(는) {city}(으)로 갔다", "철수는 서울로 갔다"},
+		{"{city}(으)로부터", "서울로부터"},
+		{"{n}개를", "3개를"},
+		{"{n}(이)가", "3이"},
+		{"{unknown}(이)가", "{unknown}(이)가"},
+		{"{name}(에)서", "철수(에)서"},
+		{"{name}", "철수"},
+		{"{name", "{name"},
+		{"3{을/를} 샀다", "3을 샀다"},
+		{"{n}{은/는}", "3은"},
+		{"{item}{와/과}", "책과"},
+	}
+	for _, c := range cases {
+		if actual := Format(c.template, args); actual != c.expected {
+			t.Errorf("Format(%s): expected %s, got %s", c.template, c.expected, actual)
+		}
+	}
+}
+
+func TestSprintf(t *testing.T) {
+	if s := Sprintf("%s(이)가 %d(을)를 %5.1f%%", "철수", 3, 2.5); s != "철수가 3을   2.5%" {
+		t.Errorf("unexpected %s", s)
+	}
+	if s := Sprintf("%v은(는)", "사과"); s != "사과는" {
+		t.Errorf("unexpected %s", s)
+	}
+
+	// Missing and extra arguments as fmt.Sprintf
+	for _, c := range []struct {
+		format string
+		a      []interface{}
+	}{
+		{"%s %d", []interface{}{"책"}},
+		{"%s", []interface{}{"책", 3, "x"}},
+		{"abc", []interface{}{1, nil}},
+	} {
+		if s, expected := Sprintf(c.format, c.a...), fmt.Sprintf(c.format, c.a...); s != expected {
+			t.Errorf("Sprintf(%q): expected %s, got %s", c.format, expected, s)
+		}
+	}
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	tmpl := template.Must(template.New("").Funcs(TemplateFuncs).Parse(
+		`{{josa .Name "(이)가"}} 왔다`))
+	var b strings.Builder
+	if err := tmpl.Execute(&b, map[string]string{"Name": "영희"}); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "영희가 왔다" {
+		t.Errorf("unexpected %s", b.String())
+	}
+}
+
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		r        rune
+		expected Category
+	}{
+		{'a', NotHangul},
+		{'가', PrecomposedSyllable},
+		{'힣', PrecomposedSyllable},
+		{LeadG, ConjoiningLead},
+		{MedialI, ConjoiningMedial},
+		{TailH, ConjoiningTail},
+		{G, CompatibilityJamo},
+		{I, CompatibilityJamo},
+		{0x1113, ArchaicJamo},
+		{0x3186, ArchaicJamo}, // ㆆ
+		{0xA960, ArchaicJamo},
+		{0xD7FB, ArchaicJamo},
+		{0xD7C7, NotHangul},
+		{0xFFA1, HalfwidthJamo},
+		{0xFFDC, HalfwidthJamo},
+		{0xFFBF, NotHangul},
+		{'㈀', EnclosedHangul},
+		{'㉮', EnclosedHangul},
+		{0x3220, NotHangul}, // ㈠
+		{0x115F, HangulFiller},
+		{0x3164, HangulFiller},
+	}
+	for _, c := range cases {
+		if actual := Classify(c.r); actual != c.expected {
+			t.Errorf("Classify(%U): expected %v, got %v", c.r, c.expected, actual)
+		}
+	}
+
+	// Classify agrees with range tables
+	for r := rune(0); r < 0x10000; r++ {
+		c := Classify(r)
+		if c != NotHangul && !unicode.Is(c.RangeTable(), r) {
+			t.Fatalf("%U is %v but not in its table", r, c)
+		}
+		if unicode.Is(HangulTable, r) != (c != NotHangul) {
+			t.Fatalf("%U is %v but HangulTable disagrees", r, c)
+		}
+		if IsHangul(r) != (c != NotHangul) {
+			t.Fatalf("IsHangul(%U) disagrees with %v", r, c)
+		}
+	}
+
+	fields := strings.FieldsFunc("abc한글ﾡﾢdef㉠", func(r rune) bool {
+		return !unicode.Is(HangulTable, r)
+	})
+	if len(fields) != 2 || fields[0] != "한글ﾡﾢ" || fields[1] != "㉠" {
+		t.Errorf("unexpected fields %q", fields)
+	}
+}
+
+func TestArchaicJamo(t *testing.T) {
+	cases := []struct {
+		c, l, m, t rune
+	}{
+		{Z, 0x1140, 0, 0x11EB},        // ㅿ
+		{Q, 0x1159, 0, 0x11F9},        // ㆆ
+		{YESIEUNG, 0x114C, 0, 0x11F0}, // ㆁ
+		{BV, 0x112B, 0, 0x11E6},       // ㅸ
+		{BSG, 0x1122, 0, 0},           // ㅴ
+		{ARAEA, 0, 0x119E, 0},         // ㆍ
+		{ARAEAE, 0, 0x11A1, 0},        // ㆎ
+	}
+	for _, c := range cases {
+		if Lead(c.c) != c.l || Medial(c.c) != c.m || Tail(c.c) != c.t {
+			t.Errorf("%c: expected %U %U %U, got %U %U %U", c.c,
+				c.l, c.m, c.t, Lead(c.c), Medial(c.c), Tail(c.c))
+		}
+		for _, j := range []rune{c.l, c.m, c.t} {
+			if j != 0 && CompatJamo(j) != c.c {
+				t.Errorf("CompatJamo(%U): expected %c, got %U", j, c.c, CompatJamo(j))
+			}
+		}
+	}
+
+	// Every archaic compatibility jamo maps to conjoining one
+	for c := rune(NN); c <= ARAEAE; c++ {
+		if Lead(c) == 0 && Medial(c) == 0 && Tail(c) == 0 {
+			t.Errorf("%c has no conjoining jamo", c)
+		}
+		if IsJaeum(c) == IsMoeum(c) {
+			t.Errorf("%c: IsJaeum and IsMoeum should differ", c)
+		}
+	}
+
+	if es, ok := SplitMultiElement(BSG); !ok || string(es) != "ㅂㅅㄱ" {
+		t.Errorf("SplitMultiElement(ㅴ): got %q", string(es))
+	}
+	if !IsMoeum(0x119E) || !IsJaeum(0xA960) || IsJaeum(leadFiller) {
+		t.Errorf("unexpected IsJaeum or IsMoeum of archaic jamo")
+	}
+}
+
+func TestSyllableBlock(t *testing.T) {
+	// ᄒᆞᆫ글 ᄫᅡ
+	s := "ᄒᆞᆫ글 ᄫᅡ"
+	expected := []string{"ᄒᆞᆫ", "글", " ", "ᄫᅡ"}
+	if actual := SegmentBlocks(s); strings.Join(actual, "|") != strings.Join(expected, "|") {
+		t.Errorf("SegmentBlocks: expected %q, got %q", expected, actual)
+	}
+	// Tone mark stays in the block
+	if actual := SegmentBlocks("ᄀᆞ〮ᄂ"); len(actual) != 2 {
+		t.Errorf("SegmentBlocks with tone mark: got %q", actual)
+	}
+
+	valid := []string{"ᄀᆞ", "ᄒᆞᆫ", "간ᇫ", "ᄉ가", "ᅟᅡ", "가"}
+	for _, b := range valid {
+		if !IsSyllableBlock(b) {
+			t.Errorf("IsSyllableBlock(%q): expected true", b)
+		}
+	}
+	invalid := []string{"", "ᄀ", "ᆞ", "ᅡᄀ", "가나", "ᆨ", "a"}
+	for _, b := range invalid {
+		if IsSyllableBlock(b) {
+			t.Errorf("IsSyllableBlock(%q): expected false", b)
+		}
+	}
+
+	l, m, tl, ok := SplitOld("간ᇫ")
+	if !ok || string(l) != "ᄀ" || string(m) != "ᅡ" || string(tl) != "ᆫᇫ" {
+		t.Errorf("SplitOld: got %q %q %q %v", string(l), string(m), string(tl), ok)
+	}
+	if _, _, _, ok := SplitOld("ᄀ"); ok {
+		t.Errorf("SplitOld of incomplete block should fail")
+	}
+
+	joins := []struct {
+		l, m, t  []rune
+		expected string
+	}{
+		{[]rune{G}, []rune{A}, nil, "가"},
+		{[]rune{G}, []rune{A}, []rune{N}, "간"},
+		{[]rune{G}, []rune{ARAEA}, nil, "ᄀᆞ"},
+		{[]rune{H}, []rune{ARAEA}, []rune{N}, "ᄒᆞᆫ"},
+		{[]rune{G}, []rune{A}, []rune{N, Z}, "간ᇫ"},
+		{nil, []rune{A}, nil, "ᅟᅡ"},
+		{[]rune{'a'}, []rune{A}, nil, "�"},
+	}
+	for _, c := range joins {
+		if actual := JoinOld(c.l, c.m, c.t); actual != c.expected {
+			t.Errorf("JoinOld(%q, %q, %q): expected %q, got %q",
+				string(c.l), string(c.m), string(c.t), c.expected, actual)
+		}
+	}
+}
+
+func TestHalfwidth(t *testing.T) {
+	for r := rune(0xFFA0); r <= 0xFFDC; r++ {
+		c := ToFullwidth(r)
+		if (c != 0) != (Classify(r) != NotHangul) {
+			t.Errorf("ToFullwidth(%U): got %U", r, c)
+		}
+		if c != 0 && ToHalfwidth(c) != r {
+			t.Errorf("ToHalfwidth(%U): expected %U, got %U", c, r, ToHalfwidth(c))
+		}
+	}
+	for r := rune(G); r <= I; r++ {
+		if ToFullwidth(ToHalfwidth(r)) != r {
+			t.Errorf("%c does not round-trip", r)
+		}
+	}
+	if ToHalfwidth(G) != 'ﾡ' || ToHalfwidth(A) != 'ￂ' || ToHalfwidth(I) != 'ￜ' || ToHalfwidth('a') != 0 {
+		t.Errorf("unexpected ToHalfwidth")
+	}
+	if CompatJamo('ﾡ') != G || Lead('ﾡ') != LeadG || Medial('ￂ') != MedialA || Tail('ﾾ') != TailH {
+		t.Errorf("halfwidth jamo should be converted")
+	}
+
+	cases := []struct {
+		in, expected string
+	}{
+		{"ﾡￂﾤ", "간"},
+		{"ﾡￂﾤￂ", "가나"},
+		{"ﾾￌￂﾷ 123", "황 123"}, // ㅎㅗㅏㅇ
+		{"ﾡ", "ㄱ"},
+		{"abcﾡￂ", "abc가"},
+		{"ﾡￂ\xffﾤￂ", "가\xff나"},
+	}
+	for _, c := range cases {
+		if actual := FromHalfwidth(c.in); actual != c.expected {
+			t.Errorf("FromHalfwidth(%q): expected %q, got %q", c.in, c.expected, actual)
+		}
+	}
+
+	in := strings.Repeat("ﾡￂﾤ ", 2000)
+	r := NewHalfwidthReader(iotest.OneByteReader(strings.NewReader(in)))
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != strings.Repeat("간 ", 2000) {
+		t.Errorf("unexpected output of NewHalfwidthReader")
+	}
+}
+
+func TestSyllables(t *testing.T) {
+	// 한 in NFD, 글 precomposed, ᄒᆞᆫ in Old Hangul, then a tone mark
+	s := "한글 ᄒᆞᆫ〮!"
+	expected := []struct {
+		offset int
+		text   string
+	}{
+		{0, "한"},
+		{9, "글"},
+		{12, " "},
+		{13, "ᄒᆞᆫ〮"},
+		{25, "!"},
+	}
+	i := 0
+	for it := Syllables(s); it.Next(); i++ {
+		if i >= len(expected) {
+			t.Fatalf("too many syllables")
+		}
+		if it.Offset() != expected[i].offset || it.Text() != expected[i].text {
+			t.Errorf("syllable %d: expected %d %q, got %d %q", i,
+				expected[i].offset, expected[i].text, it.Offset(), it.Text())
+		}
+	}
+	if i != len(expected) {
+		t.Errorf("expected %d syllables, got %d", len(expected), i)
+	}
+	if n := CountSyllables(s); n != len(expected) {
+		t.Errorf("CountSyllables: expected %d, got %d", len(expected), n)
+	}
+
+	// LV followed by T, and L followed by LV
+	if n := CountSyllables("각ᄀ가\r\n"); n != 3 {
+		t.Errorf("CountSyllables: expected 3, got %d", n)
+	}
+	if n := CountSyllables(""); n != 0 {
+		t.Errorf("CountSyllables of empty string: got %d", n)
+	}
+
+	truncates := []struct {
+		n        int
+		expected string
+	}{
+		{0, ""},
+		{1, "한"},
+		{2, "한글"},
+		{4, "한글 ᄒᆞᆫ〮"},
+		{10, s},
+	}
+	for _, c := range truncates {
+		if actual := Truncate(s, c.n); actual != c.expected {
+			t.Errorf("Truncate(%d): expected %q, got %q", c.n, c.expected, actual)
+		}
+	}
+}
+
+func TestGroupByChosung(t *testing.T) {
+	ss := []string{"나비", "까치", "가방", "Apple", "123", "金氏", "ㄳ", "아이", "banana", "ㅏ", "따옴표", ""}
+	bs := GroupByChosung(ss)
+	var actual []string
+	for _, b := range bs {
+		actual = append(actual, b.Label+":"+strings.Join(b.Items, ","))
+	}
+	expected := []string{
+		"ㄱ:까치,가방,金氏,ㄳ",
+		"ㄴ:나비",
+		"ㄷ:따옴표",
+		"ㅇ:아이",
+		"A:Apple",
+		"B:banana",
+		"#:123,ㅏ,",
+	}
+	if strings.Join(actual, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+
+	g := Grouper{SeparateDouble: true, NoLatin: true}
+	bs = g.Group(ss)
+	actual = nil
+	for _, b := range bs {
+		actual = append(actual, b.Label+":"+strings.Join(b.Items, ","))
+	}
+	expected = []string{
+		"ㄱ:가방,金氏,ㄳ",
+		"ㄲ:까치",
+		"ㄴ:나비",
+		"ㄸ:따옴표",
+		"ㅇ:아이",
+		"#:Apple,123,banana,ㅏ,",
+	}
+	if strings.Join(actual, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+
+	labels := []struct {
+		s, expected string
+	}{
+		{"까", "ㄱ"}, // conjoining
+		{"ﾡ", "ㄱ"},  // halfwidth
+		{"ㅴ", "ㅂ"},  // archaic cluster
+		{"ㅿ", "#"},  // archaic
+		{"ｱ", "#"},  // halfwidth katakana
+	}
+	var def Grouper
+	for _, c := range labels {
+		if actual := def.Label(c.s); actual != c.expected {
+			t.Errorf("Label(%q): expected %s, got %s", c.s, c.expected, actual)
+		}
+	}
+}
+
+// Map based implementations, as reference of the lookup tables
+
+// Reference maps of jamo, which the lookup tables of jamo.go should agree
+// with.
+var multiElements = map[rune][]rune{
+	GG:  []rune{G, G},
+	GS:  []rune{G, S},
+	NJ:  []rune{N, J},
+	NH:  []rune{N, H},
+	DD:  []rune{D, D},
+	LG:  []rune{L, G},
+	LM:  []rune{L, M},
+	LB:  []rune{L, B},
+	LS:  []rune{L, S},
+	LT:  []rune{L, T},
+	LP:  []rune{L, P},
+	LH:  []rune{L, H},
+	BB:  []rune{B, B},
+	BS:  []rune{B, S},
+	SS:  []rune{S, S},
+	JJ:  []rune{J, J},
+	AE:  []rune{A, I},
+	E:   []rune{EO, I},
+	YAE: []rune{YA, I},
+	YE:  []rune{YEO, I},
+	WA:  []rune{O, A},
+	WAE: []rune{O, A, I},
+	OE:  []rune{O, I},
+	WEO: []rune{U, EO},
+	WE:  []rune{U, E},
+	WI:  []rune{U, I},
+	YI:  []rune{EU, I},
+
+	// Archaic
+	NN:        []rune{N, N},
+	ND:        []rune{N, D},
+	NS:        []rune{N, S},
+	NZ:        []rune{N, Z},
+	LGS:       []rune{L, G, S},
+	LD:        []rune{L, D},
+	LBS:       []rune{L, B, S},
+	LZ:        []rune{L, Z},
+	LQ:        []rune{L, Q},
+	MB:        []rune{M, B},
+	MS:        []rune{M, S},
+	MZ:        []rune{M, Z},
+	BG:        []rune{B, G},
+	BD:        []rune{B, D},
+	BSG:       []rune{B, S, G},
+	BSD:       []rune{B, S, D},
+	BJ:        []rune{B, J},
+	BT:        []rune{B, T},
+	SG:        []rune{S, G},
+	SN:        []rune{S, N},
+	SD:        []rune{S, D},
+	SB:        []rune{S, B},
+	SJ:        []rune{S, J},
+	ZSZS:      []rune{ZS, ZS},
+	YESIEUNGS: []rune{YESIEUNG, S},
+	YESIEUNGZ: []rune{YESIEUNG, Z},
+	HH:        []rune{H, H},
+	YOYA:      []rune{YO, YA},
+	YOYAE:     []rune{YO, YAE},
+	YOI:       []rune{YO, I},
+	YUYEO:     []rune{YU, YEO},
+	YUYE:      []rune{YU, YE},
+	YUI:       []rune{YU, I},
+	ARAEAE:    []rune{ARAEA, I},
+}
+
+var toCompatJamo = map[rune]rune{
+	LeadG:  G,
+	TailG:  G,
+	LeadGG: GG,
+	TailGG: GG,
+	TailGS: GS,
+	LeadN:  N,
+	TailN:  N,
+	TailNJ: NJ,
+	TailNH: NH,
+	LeadD:  D,
+	TailD:  D,
+	LeadDD: DD,
+	LeadR:  L,
+	TailL:  L,
+	TailLG: LG,
+	TailLM: LM,
+	TailLB: LB,
+	TailLS: LS,
+	TailLT: LT,
+	TailLP: LP,
+	TailLH: LH,
+	LeadM:  M,
+	TailM:  M,
+	LeadB:  B,
+	TailB:  B,
+	LeadBB: BB,
+	TailBS: BS,
+	LeadS:  S,
+	TailS:  S,
+	LeadSS: SS,
+	TailSS: SS,
+	LeadZS: ZS,
+	TailNG: ZS,
+	LeadJ:  J,
+	TailJ:  J,
+	LeadJJ: JJ,
+	LeadC:  C,
+	TailC:  C,
+	LeadK:  K,
+	TailK:  K,
+	LeadT:  T,
+	TailT:  T,
+	LeadP:  P,
+	TailP:  P,
+	LeadH:  H,
+	TailH:  H,
+}
+
+var toLead = map[rune]rune{
+	G:  LeadG,
+	GG: LeadGG,
+	N:  LeadN,
+	D:  LeadD,
+	DD: LeadDD,
+	L:  LeadR,
+	M:  LeadM,
+	B:  LeadB,
+	BB: LeadBB,
+	S:  LeadS,
+	SS: LeadSS,
+	ZS: LeadZS,
+	J:  LeadJ,
+	JJ: LeadJJ,
+	C:  LeadC,
+	K:  LeadK,
+	T:  LeadT,
+	P:  LeadP,
+	H:  LeadH,
+}
+
+var toTail = map[rune]rune{
+	G:  TailG,
+	GG: TailGG,
+	GS: TailGS,
+	N:  TailN,
+	NJ: TailNJ,
+	NH: TailNH,
+	D:  TailD,
+	L:  TailL,
+	LG: TailLG,
+	LM: TailLM,
+	LB: TailLB,
+	LS: TailLS,
+	LT: TailLT,
+	LP: TailLP,
+	LH: TailLH,
+	M:  TailM,
+	B:  TailB,
+	BS: TailBS,
+	S:  TailS,
+	SS: TailSS,
+	ZS: TailNG,
+	J:  TailJ,
+	C:  TailC,
+	K:  TailK,
+	T:  TailT,
+	P:  TailP,
+	H:  TailH,
+}
+
+// Archaic compatibility jamo to lead consonants
+var archaicLeads = map[rune]rune{
+	NN:       0x1114,
+	ND:       0x1115,
+	NS:       0x115B,
+	LD:       0xA966,
+	MB:       0x111C,
+	MS:       0xA971,
+	MV:       0x111D,
+	BG:       0x111E,
+	BD:       0x1120,
+	BSG:      0x1122,
+	BSD:      0x1123,
+	BJ:       0x1127,
+	BT:       0x1129,
+	BV:       0x112B,
+	BBV:      0x112C,
+	SG:       0x112D,
+	SN:       0x112E,
+	SD:       0x112F,
+	SB:       0x1132,
+	SJ:       0x1136,
+	Z:        0x1140,
+	ZSZS:     0x1147,
+	YESIEUNG: 0x114C,
+	PV:       0x1157,
+	HH:       0x1158,
+	Q:        0x1159,
+}
+
+// Archaic compatibility jamo to medial vowels
+var archaicMedials = map[rune]rune{
+	YOYA:   0x1184,
+	YOYAE:  0x1185,
+	YOI:    0x1188,
+	YUYEO:  0x1191,
+	YUYE:   0x1192,
+	YUI:    0x1194,
+	ARAEA:  0x119E,
+	ARAEAE: 0x11A1,
+}
+
+// Archaic compatibility jamo to tail consonants
+var archaicTails = map[rune]rune{
+	NN:        0x11FF,
+	ND:        0x11C6,
+	NS:        0x11C7,
+	NZ:        0x11C8,
+	LGS:       0x11CC,
+	LD:        0x11CE,
+	LBS:       0x11D3,
+	LZ:        0x11D7,
+	LQ:        0x11D9,
+	MB:        0x11DC,
+	MS:        0x11DD,
+	MZ:        0x11DF,
+	MV:        0x11E2,
+	BD:        0xD7E3,
+	BSD:       0xD7E7,
+	BJ:        0xD7E8,
+	BV:        0x11E6,
+	SG:        0x11E7,
+	SD:        0x11E8,
+	SB:        0x11EA,
+	SJ:        0xD7EF,
+	Z:         0x11EB,
+	ZSZS:      0x11EE,
+	YESIEUNG:  0x11F0,
+	YESIEUNGS: 0x11F1,
+	YESIEUNGZ: 0x11F2,
+	PV:        0x11F4,
+	Q:         0x11F9,
+}
+
+func init() {
+	for c, l := range archaicLeads {
+		toLead[c] = l
+		toCompatJamo[l] = c
+	}
+	for c, m := range archaicMedials {
+		toCompatJamo[m] = c
+	}
+	for c, t := range archaicTails {
+		toTail[c] = t
+		toCompatJamo[t] = c
+	}
+}
+
+func mapCompatJamo(r rune) rune {
+	switch {
+	case G <= r && r <= H, A <= r && r <= I, NN <= r && r <= ARAEAE:
+		return r
+	case MedialA <= r && r <= MedialI:
+		return r - medialBase + A
+	}
+	if c, ok := toCompatJamo[r]; ok {
+		return c
+	}
+	if c := ToFullwidth(r); c != compatFiller {
+		return c
+	}
+	return 0
+}
+
+func mapLead(c rune) rune {
+	if f := ToFullwidth(c); f != 0 {
+		c = f
+	}
+	if isOldLead(c) && c != leadFiller {
+		return c
+	}
+	return toLead[c]
+}
+
+func mapMedial(c rune) rune {
+	if f := ToFullwidth(c); f != 0 {
+		c = f
+	}
+	switch {
+	case MedialA <= c && c <= MedialI:
+		return c
+	case A <= c && c <= I:
+		return c - A + medialBase
+	case isOldMedial(c) && c != medialFiller:
+		return c
+	}
+	return archaicMedials[c]
+}
+
+func mapTail(c rune) rune {
+	if f := ToFullwidth(c); f != 0 {
+		c = f
+	}
+	if isOldTail(c) {
+		return c
+	}
+	return toTail[c]
+}
+
+func mapSplitMultiElement(r rune) ([]rune, bool) {
+	es, ok := multiElements[mapCompatJamo(r)]
+	return es, ok
+}
+
+func mapStroke(r rune) (c int) {
+	if isSyllable(r) {
+		l, m, t := Split(r)
+		return mapStroke(l) + mapStroke(m) + mapStroke(t)
+	}
+	jm := mapCompatJamo(r)
+	if es, ok := mapSplitMultiElement(jm); ok {
+		for _, e := range es {
+			c += strokes[e]
+		}
+		return c
+	}
+	return strokes[jm]
+}
+
+func TestJamoTables(t *testing.T) {
+	// Every code point in the tables, and the BMP around them
+	for r := rune(0); r <= 0xFFFF; r++ {
+		if a, e := CompatJamo(r), mapCompatJamo(r); a != e {
+			t.Errorf("CompatJamo(%U): expected %U, got %U", r, e, a)
+		}
+		if a, e := Lead(r), mapLead(r); a != e {
+			t.Errorf("Lead(%U): expected %U, got %U", r, e, a)
+		}
+		if a, e := Medial(r), mapMedial(r); a != e {
+			t.Errorf("Medial(%U): expected %U, got %U", r, e, a)
+		}
+		if a, e := Tail(r), mapTail(r); a != e {
+			t.Errorf("Tail(%U): expected %U, got %U", r, e, a)
+		}
+		a, aok := SplitMultiElement(r)
+		e, eok := mapSplitMultiElement(r)
+		if aok != eok || string(a) != string(e) {
+			t.Errorf("SplitMultiElement(%U): expected %q %v, got %q %v", r, e, eok, a, aok)
+		}
+		if a, e := Stroke(r), mapStroke(r); a != e {
+			t.Errorf("Stroke(%U): expected %d, got %d", r, e, a)
+		}
+	}
+}
+
+func TestJamoAllocs(t *testing.T) {
+	b := []byte("ᄒᆞᆫ글 \uFFA1\uFFC2 광화문")
+	n := testing.AllocsPerRun(100, func() {
+		for r := rune(0x1100); r <= 0xFFDC; r += 7 {
+			CompatJamo(r)
+			Lead(r)
+			Medial(r)
+			Tail(r)
+			SplitMultiElement(r)
+			Stroke(r)
+		}
+		CompatJamoBytes(b)
+		ChosungBytes(b)
+	})
+	if n != 0 {
+		t.Errorf("expected no allocation, got %v", n)
+	}
+}
+
+func TestBytes(t *testing.T) {
+	b := CompatJamoBytes([]byte("ᄒᆞᆫ글 \uFFA1\uFFC2\xff 가"))
+	if expected := "ㅎㆍㄴ글 ㄱㅏ\xff 가"; string(b) != expected {
+		t.Errorf("expected %q, got %q", expected, b)
+	}
+	b = ChosungBytes([]byte("광화문 Gate\xff"))
+	if expected := "ㄱㅎㅁ Gate\xff"; string(b) != expected {
+		t.Errorf("expected %q, got %q", expected, b)
+	}
+}
+
+var benchText = strings.Repeat("대한민국 서울특별시 종로구 세종대로 175 ", 100)
+
+func BenchmarkStroke(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, r := range benchText {
+			Stroke(r)
+		}
+	}
+}
+
+func BenchmarkStrokeMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, r := range benchText {
+			mapStroke(r)
+		}
+	}
+}
+
+func BenchmarkCompatJamo(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for r := rune(0x1100); r <= 0x11FF; r++ {
+			CompatJamo(r)
+		}
+	}
+}
+
+func BenchmarkCompatJamoMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for r := rune(0x1100); r <= 0x11FF; r++ {
+			mapCompatJamo(r)
+		}
+	}
+}
+
+func BenchmarkLeadTail(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for r := rune(G); r <= ARAEAE; r++ {
+			Lead(r)
+			Tail(r)
+		}
+	}
+}
+
+func BenchmarkLeadTailMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for r := rune(G); r <= ARAEAE; r++ {
+			mapLead(r)
+			mapTail(r)
+		}
+	}
+}
+
+func BenchmarkChosung(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Chosung(benchText)
+	}
+}
+
+func BenchmarkChosungBytes(b *testing.B) {
+	buf := []byte(benchText)
+	for i := 0; i < b.N; i++ {
+		copy(buf, benchText)
+		ChosungBytes(buf)
+	}
+}
+
+func TestSplitJoinE(t *testing.T) {
+	for r := rune(0xAC00); r <= 0xD7A3; r++ {
+		l, m, tail, err := SplitE(r)
+		if err != nil {
+			t.Fatalf("SplitE(%c): %v", r, err)
+		}
+		if j, err := JoinE(l, m, tail); err != nil || j != r {
+			t.Fatalf("JoinE(SplitE(%c)): got %c, %v", r, j, err)
+		}
+		l, m, tail = SplitCompat(r)
+		if j, err := JoinE(l, m, tail); err != nil || j != r {
+			t.Fatalf("JoinE(SplitCompat(%c)): got %c, %v", r, j, err)
+		}
+	}
+
+	for _, r := range "A가ᄀㄱ�" {
+		_, _, _, err := SplitE(r)
+		if isSyllable(r) != (err == nil) {
+			t.Errorf("SplitE(%q): got %v", r, err)
+		}
+	}
+	if l, m, t2 := Split('A'); l != 0 || m != 0 || t2 != 0 {
+		t.Errorf("Split('A'): expected 0s, got %U %U %U", l, m, t2)
+	}
+
+	cases := []struct {
+		l, m, t rune
+		err     error
+	}{
+		{G, A, 0, nil},
+		{LeadG, MedialA, TailG, nil},
+		{0xFFA1, 0xFFC2, 0xFFA1, nil}, // halfwidth
+		{A, A, 0, ErrInvalidLead},
+		{ARAEA, A, 0, ErrInvalidLead}, // archaic
+		{G, G, 0, ErrInvalidMedial},
+		{G, ARAEA, 0, ErrInvalidMedial},
+		{G, A, A, ErrInvalidTail},
+		{G, A, DD, ErrInvalidTail},
+		{G, A, 'A', ErrInvalidTail},
+	}
+	for _, c := range cases {
+		if _, err := JoinE(c.l, c.m, c.t); err != c.err {
+			t.Errorf("JoinE(%U, %U, %U): expected %v, got %v", c.l, c.m, c.t, c.err, err)
+		}
+		if r := Join(c.l, c.m, c.t); (r == 0xFFFD) != (c.err != nil) {
+			t.Errorf("Join(%U, %U, %U): got %c", c.l, c.m, c.t, r)
+		}
+	}
+
+	if EndsWithConsonant("abc") || LastConsonant("강a") != 0 {
+		t.Error("words end with Latin letters should not end with consonant")
+	}
+}
+
+// quickRunes generates runes, mostly around the Hangul blocks
+func quickRunes(args []reflect.Value, rnd *rand.Rand) {
+	blocks := [][2]rune{
+		{0, 0x10FFFF},
+		{0x1100, 0x11FF},
+		{0x3130, 0x318F},
+		{0xA960, 0xA97F},
+		{0xAC00, 0xD7FF},
+		{0xFFA0, 0xFFDF},
+	}
+	for i := range args {
+		b := blocks[rnd.Intn(len(blocks))]
+		args[i] = reflect.ValueOf(b[0] + rune(rnd.Int63n(int64(b[1]-b[0]+1))))
+	}
+}
+
+func TestQuick(t *testing.T) {
+	cfg := &quick.Config{MaxCount: 100000, Values: quickRunes}
+
+	// Nothing panics on any rune
+	noPanic := func(a, b, c rune) bool {
+		Split(a)
+		SplitCompat(a)
+		Join(a, b, c)
+		SplitMultiElement(a)
+		Stroke(a)
+		IsJaeum(a)
+		IsMoeum(a)
+		Classify(a)
+		ToHalfwidth(a)
+		ToFullwidth(a)
+		s := string([]rune{a, b, c})
+		EndsWithConsonant(s)
+		AppendPostposition(s, "이", "가")
+		Chosung(s)
+		ChosungBytes([]byte(s))
+		CompatJamoBytes([]byte(s))
+		return true
+	}
+	if err := quick.Check(noPanic, cfg); err != nil {
+		t.Error(err)
+	}
+
+	// Split and Join are inverse of each other
+	splitJoin := func(r rune) bool {
+		l, m, t, err := SplitE(r)
+		if err != nil {
+			return !isSyllable(r)
+		}
+		j, err := JoinE(l, m, t)
+		return err == nil && j == r
+	}
+	if err := quick.Check(splitJoin, cfg); err != nil {
+		t.Error(err)
+	}
+	joinSplit := func(l, m, t rune) bool {
+		r, err := JoinE(l, m, t)
+		if err != nil {
+			return r == 0 && Join(l, m, t) == 0xFFFD
+		}
+		sl, sm, st, err := SplitE(r)
+		return err == nil && sl == Lead(l) && sm == Medial(m) && st == Tail(t)
+	}
+	if err := quick.Check(joinSplit, cfg); err != nil {
+		t.Error(err)
+	}
+
+	// Compatibility jamo convert back and forth
+	compat := func(r rune) bool {
+		c := CompatJamo(r)
+		if c == 0 {
+			// Archaic conjoining jamo may have no compatibility jamo
+			for _, j := range []rune{Lead(r), Medial(r), Tail(r)} {
+				if j != 0 && j != r {
+					return false
+				}
+			}
+			return true
+		}
+		if CompatJamo(c) != c {
+			return false
+		}
+		if f := ToHalfwidth(c); f != 0 && ToFullwidth(f) != c {
+			return false
+		}
+		for _, j := range []rune{Lead(r), Medial(r), Tail(r)} {
+			if j != 0 && CompatJamo(j) != c {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(compat, cfg); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestJamo(t *testing.T) {
+	cases := []struct {
+		j                        Jamo
+		compat, lead, tail       Jamo
+		components               string
+		double, tense, aspirated bool
+		strokes                  int
+	}{
+		{G, G, LeadG, TailG, "ㄱ", false, false, false, 1},
+		{LeadGG, GG, LeadGG, TailGG, "ㄱㄱ", true, true, false, 2},
+		{0xFFA3, GS, 0, TailGS, "ㄱㅅ", false, false, false, 3}, // halfwidth
+		{TailT, T, LeadT, TailT, "ㅌ", false, false, true, 3},
+		{HH, HH, 0x1158, 0, "ㅎㅎ", true, false, false, 6},
+		{WA, WA, 0, 0, "ㅗㅏ", false, false, false, 4},
+		{'A', 0, 0, 0, "", false, false, false, 0},
+	}
+	for _, c := range cases {
+		if a := c.j.Compat(); a != c.compat {
+			t.Errorf("%U.Compat(): expected %v, got %v", c.j, c.compat, a)
+		}
+		if a := c.j.AsLead(); a != c.lead {
+			t.Errorf("%U.AsLead(): expected %U, got %U", c.j, c.lead, a)
+		}
+		if a := c.j.AsTail(); a != c.tail {
+			t.Errorf("%U.AsTail(): expected %U, got %U", c.j, c.tail, a)
+		}
+		var cs string
+		for _, e := range c.j.Components() {
+			cs += e.String()
+		}
+		if cs != c.components {
+			t.Errorf("%U.Components(): expected %s, got %s", c.j, c.components, cs)
+		}
+		if c.j.IsDouble() != c.double || c.j.IsTense() != c.tense ||
+			c.j.IsAspirated() != c.aspirated {
+			t.Errorf("%U: wrong IsDouble, IsTense or IsAspirated", c.j)
+		}
+		if a := c.j.Strokes(); a != c.strokes {
+			t.Errorf("%U.Strokes(): expected %d, got %d", c.j, c.strokes, a)
+		}
+	}
+
+	var j Jamo
+	if err := j.UnmarshalText([]byte("ㄲ")); err != nil || j != GG {
+		t.Errorf("UnmarshalText: got %v, %v", j, err)
+	}
+	if err := j.UnmarshalText([]byte("가")); err != ErrNotJamo {
+		t.Errorf("UnmarshalText: expected %v, got %v", ErrNotJamo, err)
+	}
+}
+
+func TestSyllable(t *testing.T) {
+	s, err := NewSyllable(H, A, N)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Lead != LeadH || s.Medial != MedialA || s.Tail != TailN {
+		t.Errorf("NewSyllable: expected conjoining jamo, got %v", s)
+	}
+	if s.LeadIndex() != 18 || s.MedialIndex() != 0 || s.TailIndex() != 4 {
+		t.Errorf("wrong indexes %d %d %d", s.LeadIndex(), s.MedialIndex(), s.TailIndex())
+	}
+	if s.String() != "한" || s.Rune() != '한' {
+		t.Errorf("expected 한, got %s", s)
+	}
+	if _, err := NewSyllable(A, A, 0); err != ErrInvalidLead {
+		t.Errorf("expected %v, got %v", ErrInvalidLead, err)
+	}
+	if _, err := SyllableOf('A'); err != ErrNotSyllable {
+		t.Errorf("expected %v, got %v", ErrNotSyllable, err)
+	}
+
+	type word struct {
+		Syllables []Syllable
+		Jamo      Jamo
+	}
+	b, err := json.Marshal(word{[]Syllable{s, {LeadG, MedialEU, TailL}}, GS})
+	if expected := `{"Syllables":["한","글"],"Jamo":"ㄳ"}`; err != nil || string(b) != expected {
+		t.Errorf("json.Marshal: expected %s, got %s, %v", expected, b, err)
+	}
+	var w word
+	if err := json.Unmarshal(b, &w); err != nil || len(w.Syllables) != 2 ||
+		w.Syllables[1].String() != "글" || w.Jamo != GS {
+		t.Errorf("json.Unmarshal: got %v, %v", w, err)
+	}
+	if err := json.Unmarshal([]byte(`["한글"]`), &w.Syllables); err == nil {
+		t.Error("json.Unmarshal: expected error for two syllables")
+	}
+	if _, err := json.Marshal(Syllable{Lead: LeadG}); err == nil {
+		t.Error("json.Marshal: expected error for invalid Syllable")
+	}
+
+	// Zero value is marshaled as empty string, and back
+	type unset struct {
+		S Syllable
+	}
+	b, err = json.Marshal(unset{})
+	if expected := `{"S":""}`; err != nil || string(b) != expected {
+		t.Errorf("json.Marshal: expected %s, got %s, %v", expected, b, err)
+	}
+	u := unset{s}
+	if err := json.Unmarshal(b, &u); err != nil || u.S != (Syllable{}) {
+		t.Errorf("json.Unmarshal: expected zero value, got %v, %v", u.S, err)
+	}
+	if u.S.String() != "" {
+		t.Errorf("String: expected empty for zero value, got %q", u.S.String())
+	}
+	var z Syllable
+	if text, err := z.MarshalText(); err != nil || string(text) != z.String() {
+		t.Errorf("MarshalText: expected %q as String, got %q, %v", z.String(), text, err)
+	}
+}
+
+func TestPhonology(t *testing.T) {
+	seven := map[rune]bool{
+		TailG: true, TailN: true, TailD: true, TailL: true,
+		TailM: true, TailB: true, TailNG: true,
+	}
+	for tail := rune(TailG); tail <= TailH; tail++ {
+		r := RepresentativeTail(tail)
+		if !seven[r] {
+			t.Errorf("RepresentativeTail(%U): got %U", tail, r)
+		}
+		if seven[tail] && r != tail {
+			t.Errorf("RepresentativeTail(%U): expected itself, got %U", tail, r)
+		}
+	}
+	tails := []struct {
+		t, expected rune
+	}{
+		{TailK, TailG},
+		{TailH, TailD},
+		{TailLG, TailG},
+		{TailLP, TailB},
+		{LB, TailL}, // compatibility jamo
+		{LeadG, 0},
+		{A, 0},
+	}
+	for _, c := range tails {
+		if r := RepresentativeTail(c.t); r != c.expected {
+			t.Errorf("RepresentativeTail(%U): expected %U, got %U", c.t, c.expected, r)
+		}
+	}
+
+	consonants := []struct {
+		r rune
+		f ConsonantFeature
+	}{
+		{G, ConsonantFeature{Velar, Plosive, Plain}},
+		{LeadP, ConsonantFeature{Bilabial, Plosive, Aspirated}},
+		{TailNG, ConsonantFeature{Velar, Nasal, Plain}},
+		{0xFFA9, ConsonantFeature{Alveolar, Liquid, Plain}}, // halfwidth ㄹ
+		{JJ, ConsonantFeature{Palatal, Affricate, Tense}},
+	}
+	for _, c := range consonants {
+		if f, ok := ConsonantFeatures(c.r); !ok || f != c.f {
+			t.Errorf("ConsonantFeatures(%U): expected %v, got %v %v", c.r, c.f, f, ok)
+		}
+	}
+	for _, r := range []rune{LeadZS, GS, A, 'A'} {
+		if f, ok := ConsonantFeatures(r); ok {
+			t.Errorf("ConsonantFeatures(%U): expected none, got %v", r, f)
+		}
+	}
+
+	vowels := []struct {
+		r rune
+		f VowelFeature
+		h Harmony
+	}{
+		{A, VowelFeature{Low, Back, false, NoGlide}, Yang},
+		{MedialU, VowelFeature{High, Back, true, NoGlide}, Yin},
+		{OE, VowelFeature{Mid, Front, true, NoGlide}, Yang},
+		{WEO, VowelFeature{Mid, Back, false, GlideW}, Yin},
+		{YI, VowelFeature{High, Front, false, GlideEU}, Yin},
+		{I, VowelFeature{High, Front, false, NoGlide}, Neutral},
+	}
+	for _, c := range vowels {
+		if f, ok := VowelFeatures(c.r); !ok || f != c.f {
+			t.Errorf("VowelFeatures(%U): expected %v, got %v %v", c.r, c.f, f, ok)
+		}
+		if h, ok := VowelHarmony(c.r); !ok || h != c.h {
+			t.Errorf("VowelHarmony(%U): expected %v, got %v %v", c.r, c.h, h, ok)
+		}
+	}
+	// Every modern vowel has features and harmony
+	for m := rune(MedialA); m <= MedialI; m++ {
+		_, fok := VowelFeatures(m)
+		_, hok := VowelHarmony(m)
+		if !fok || !hok {
+			t.Errorf("%U: missing vowel features", m)
+		}
+	}
+	if _, ok := VowelFeatures(G); ok {
+		t.Error("VowelFeatures(ㄱ): expected none")
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+// InputMethod is a stateful Hangul input automaton which takes
+// key events one at a time.
+type InputMethod interface {
+	// Process takes a key and updates preedit and committed text.
+	// It returns false if the key is not a Hangul key.
+	Process(key rune) bool
+	// Backspace removes the last typed jamo of preedit.
+	// It returns false if preedit is empty.
+	Backspace() bool
+	// Preedit returns the syllable being typed.
+	Preedit() string
+	// Committed returns the text completed so far.
+	Committed() string
+	// Flush commits preedit and returns all committed text.
+	Flush() string
+	// Reset clears preedit and committed text.
+	Reset()
+}
+
+// syllableState holds lead, medial and tail of a syllable being typed.
+// They can be either compatibility jamo or conjoining jamo.
+type syllableState struct {
+	l, m, t rune
+}
+
+func (s syllableState) empty() bool {
+	return s.l == 0 && s.m == 0 && s.t == 0
+}
+
+func (s syllableState) runes() []rune {
+	if s.l != 0 && s.m != 0 {
+		return []rune{Join(s.l, s.m, s.t)}
+	}
+
+	// Incomplete syllable; show each jamo as is
+	var rs []rune
+	for _, r := range []rune{s.l, s.m, s.t} {
+		if r != 0 {
+			rs = append(rs, CompatJamo(r))
+		}
+	}
+	return rs
+}
+
+// automaton holds preedit and committed text which are common for
+// all input methods.
+type automaton struct {
+	committed []rune
+	cur       syllableState
+	history   []syllableState // states before each jamo of cur
+}
+
+// Backspace removes the last typed jamo of preedit. It returns false if
+// preedit is empty, so the caller can handle the key by itself.
+func (a *automaton) Backspace() bool {
+	if len(a.history) == 0 {
+		return false
+	}
+	a.cur = a.history[len(a.history)-1]
+	a.history = a.history[:len(a.history)-1]
+	return true
+}
+
+// Preedit returns the syllable being typed.
+func (a *automaton) Preedit() string {
+	return string(a.cur.runes())
+}
+
+// Committed returns the text completed so far.
+func (a *automaton) Committed() string {
+	return string(a.committed)
+}
+
+// Flush commits preedit and returns all committed text.
+// The automaton is reset after that.
+func (a *automaton) Flush() string {
+	a.commit()
+	s := string(a.committed)
+	a.Reset()
+	return s
+}
+
+// Reset clears preedit and committed text.
+func (a *automaton) Reset() {
+	a.committed = nil
+	a.cur = syllableState{}
+	a.history = nil
+}
+
+func (a *automaton) push(s syllableState) {
+	a.history = append(a.history, a.cur)
+	a.cur = s
+}
+
+func (a *automaton) startWith(s syllableState) {
+	a.commit()
+	a.push(s)
+}
+
+func (a *automaton) commit() {
+	a.committed = append(a.committed, a.cur.runes()...)
+	a.cur = syllableState{}
+	a.history = a.history[:0]
+}
+
+// commitWith commits preedit followed by r.
+func (a *automaton) commitWith(r rune) {
+	a.commit()
+	a.committed = append(a.committed, r)
+}
+
+// combineJamo returns compatibility jamo composed of a and b,
+// which is one of the candidates. Only multi-element jamo are
+// composed; ㅗ and ㅐ to ㅙ, but not ㅘ and ㅣ. It returns 0 if not
+// composable.
+func combineJamo(a, b rune, candidates []rune) rune {
+	a, b = CompatJamo(a), CompatJamo(b)
+	for _, c := range candidates {
+		es, _ := SplitMultiElement(c)
+		if len(es) < 2 || es[0] != a {
+			continue
+		}
+		// The rest is b, or elements of b; ㅙ is ㅗ, ㅏ, ㅣ
+		rest := es[1:]
+		if len(rest) == 1 && rest[0] == b {
+			return c
+		}
+		bes, _ := SplitMultiElement(b)
+		match := len(bes) == len(rest)
+		for i := 0; match && i < len(rest); i++ {
+			match = rest[i] == bes[i]
+		}
+		if match && len(rest) > 1 {
+			return c
+		}
+	}
+	return 0
+}
+
+// elements returns elements of compatibility jamo r.
+func elements(r rune) []rune {
+	if es, ok := SplitMultiElement(r); ok {
+		return append([]rune{}, es...)
+	}
+	return []rune{CompatJamo(r)}
+}
@@ -0,0 +1,122 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// HasIncrementalPrefix reports whether s begins with query, treating the
+// last syllable of query as being typed. So, "갑" is a prefix of "가방",
+// "가" is a prefix of "갈비", and "닭" is a prefix of "달걀".
+// Other runes of query are matched as HasChosungPrefix does.
+func HasIncrementalPrefix(s, query string) bool {
+	if query == "" {
+		return true
+	}
+
+	last, size := utf8.DecodeLastRuneInString(query)
+	n, ok := hasChosungPrefix(s, query[:len(query)-size])
+	if !ok {
+		return false
+	}
+	s = s[n:]
+
+	t, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return false
+	}
+	if matchChosung(t, last) {
+		return true
+	}
+	if !isSyllable(t) || !isSyllable(last) {
+		return false
+	}
+
+	ql, qm, qt := Split(last)
+	tl, tm, tt := Split(t)
+	if ql != tl {
+		return false
+	}
+	if qm != tm {
+		// ㅗ can be on the way to ㅘ
+		if qt != 0 || !hasElementsPrefix(tm, qm) {
+			return false
+		}
+		return true
+	}
+	if qt == 0 || hasElementsPrefix(tt, qt) {
+		return true
+	}
+
+	// The tail of query can be the lead of the next syllable;
+	// 갑 of 가방, or 닭 of 달걀.
+	var moved rune
+	if es, ok := SplitMultiElement(qt); ok && Lead(qt) == 0 {
+		if tt != Tail(es[0]) {
+			return false
+		}
+		moved = es[1]
+	} else {
+		if tt != 0 {
+			return false
+		}
+		moved = qt
+	}
+	next, size := utf8.DecodeRuneInString(s[size:])
+	return size > 0 && matchChosung(next, CompatJamo(moved))
+}
+
+// hasElementsPrefix reports whether jamo a can be typed following jamo b
+// on Dubeolsik keyboard; ㄺ after ㄹ, ㅘ after ㅗ.
+func hasElementsPrefix(a, b rune) bool {
+	if a == 0 || b == 0 {
+		return false
+	}
+	if CompatJamo(a) == CompatJamo(b) {
+		return true
+	}
+	compound := false
+	for _, c := range dubeolsikCompounds {
+		if c == CompatJamo(a) {
+			compound = true
+			break
+		}
+	}
+	if !compound {
+		return false
+	}
+	ae, be := elements(a), elements(b)
+	if len(be) > len(ae) {
+		return false
+	}
+	for i := range be {
+		if ae[i] != be[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SearchIncremental returns strings in the index which begin with query,
+// treating the last syllable of query as being typed.
+// See HasIncrementalPrefix for the matching rule.
+func (idx *ChosungIndex) SearchIncremental(query string) []string {
+	key := Chosung(query)
+	i := idx.lowerBound(key)
+
+	var ret []string
+	for ; i < len(idx.entries); i++ {
+		e := idx.entries[i]
+		if !strings.HasPrefix(e.key, key) {
+			break
+		}
+		if HasIncrementalPrefix(e.s, query) {
+			ret = append(ret, e.s)
+		}
+	}
+	return ret
+}
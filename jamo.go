@@ -6,26 +6,17 @@ package hangul
 
 // IsLead checks given rune is lead consonant
 func IsLead(r rune) bool {
-	if LeadG <= r && r <= LeadH {
-		return true
-	}
-	return false
+	return Classify(r) == ConjoiningLead
 }
 
 // IsMedial checks given rune is medial vowel
 func IsMedial(r rune) bool {
-	if MedialA <= r && r <= MedialI {
-		return true
-	}
-	return false
+	return Classify(r) == ConjoiningMedial
 }
 
 // IsTail checks given rune is tail consonant
 func IsTail(r rune) bool {
-	if TailG <= r && r <= TailH {
-		return true
-	}
-	return false
+	return Classify(r) == ConjoiningTail
 }
 
 // IsJaeum checks given rune is Hangul Jaeum
@@ -33,9 +24,11 @@ func IsJaeum(r rune) bool {
 	switch {
 	case G <= r && r <= H:
 		return true
-	case IsLead(r):
+	case NN <= r && r <= Q:
 		return true
-	case IsTail(r):
+	case isOldLead(r) && r != leadFiller:
+		return true
+	case isOldTail(r):
 		return true
 	}
 	return false
@@ -46,200 +39,259 @@ func IsMoeum(r rune) bool {
 	switch {
 	case A <= r && r <= I:
 		return true
-	case IsMedial(r):
+	case YOYA <= r && r <= ARAEAE:
+		return true
+	case isOldMedial(r) && r != medialFiller:
 		return true
 	}
 	return false
 }
 
-var multiElements = map[rune][]rune{
-	GG:  []rune{G, G},
-	GS:  []rune{G, S},
-	NJ:  []rune{N, J},
-	NH:  []rune{N, H},
-	DD:  []rune{D, D},
-	LG:  []rune{L, G},
-	LM:  []rune{L, M},
-	LB:  []rune{L, B},
-	LS:  []rune{L, S},
-	LT:  []rune{L, T},
-	LP:  []rune{L, P},
-	LH:  []rune{L, H},
-	BB:  []rune{B, B},
-	BS:  []rune{B, S},
-	SS:  []rune{S, S},
-	JJ:  []rune{J, J},
-	AE:  []rune{A, I},
-	E:   []rune{EO, I},
-	YAE: []rune{YA, I},
-	YE:  []rune{YEO, I},
-	WA:  []rune{O, A},
-	WAE: []rune{O, A, I},
-	OE:  []rune{O, I},
-	WEO: []rune{U, EO},
-	WE:  []rune{U, E},
-	WI:  []rune{U, I},
-	YI:  []rune{EU, I},
-}
-
 // SplitMultiElement splits multi-element compatibility jamo
 func SplitMultiElement(r rune) ([]rune, bool) {
 	r = CompatJamo(r)
-	es, ok := multiElements[r]
-	return es, ok
-}
-
-var toCompatJamo = map[rune]rune{
-	LeadG:  G,
-	TailG:  G,
-	LeadGG: GG,
-	TailGG: GG,
-	TailGS: GS,
-	LeadN:  N,
-	TailN:  N,
-	TailNJ: NJ,
-	TailNH: NH,
-	LeadD:  D,
-	TailD:  D,
-	LeadDD: DD,
-	LeadR:  L,
-	TailL:  L,
-	TailLG: LG,
-	TailLM: LM,
-	TailLB: LB,
-	TailLS: LS,
-	TailLT: LT,
-	TailLP: LP,
-	TailLH: LH,
-	LeadM:  M,
-	TailM:  M,
-	LeadB:  B,
-	TailB:  B,
-	LeadBB: BB,
-	TailBS: BS,
-	LeadS:  S,
-	TailS:  S,
-	LeadSS: SS,
-	TailSS: SS,
-	LeadZS: ZS,
-	TailNG: ZS,
-	LeadJ:  J,
-	TailJ:  J,
-	LeadJJ: JJ,
-	LeadC:  C,
-	TailC:  C,
-	LeadK:  K,
-	TailK:  K,
-	LeadT:  T,
-	TailT:  T,
-	LeadP:  P,
-	TailP:  P,
-	LeadH:  H,
-	TailH:  H,
+	if r == 0 {
+		return nil, false
+	}
+	es := compatTable[r-compatBase].elements
+	return es, es != nil
 }
 
-// CompatJamo converts lead, medial, tail to compatibility jamo
+// CompatJamo converts lead, medial, tail or halfwidth jamo to
+// compatibility jamo
 func CompatJamo(r rune) rune {
 	switch {
-	case G <= r && r <= H:
+	case r < leadBase:
+		return 0
+	case r <= oldTailEnd:
+		return jamoToCompat[r-leadBase]
+	case compatBase <= r && r <= compatEnd:
+		if r == compatFiller {
+			return 0
+		}
 		return r
-	case A <= r && r <= I:
-		return r
-	case MedialA <= r && r <= MedialI:
-		return r - medialBase + A
-	}
-	if c, ok := toCompatJamo[r]; ok {
-		return c
+	case extALeadBase <= r && r <= extALeadEnd:
+		return extAToCompat[r-extALeadBase]
+	case extBMedialBase <= r && r <= extBTailEnd:
+		return extBToCompat[r-extBMedialBase]
 	}
-
-	return 0
-}
-
-var toLead = map[rune]rune{
-	G:  LeadG,
-	GG: LeadGG,
-	N:  LeadN,
-	D:  LeadD,
-	DD: LeadDD,
-	L:  LeadR,
-	M:  LeadM,
-	B:  LeadB,
-	BB: LeadBB,
-	S:  LeadS,
-	SS: LeadSS,
-	ZS: LeadZS,
-	J:  LeadJ,
-	JJ: LeadJJ,
-	C:  LeadC,
-	K:  LeadK,
-	T:  LeadT,
-	P:  LeadP,
-	H:  LeadH,
+	return fromHalfwidth(r)
 }
 
-// Lead converts compatibility jaeum to corresponding lead consonant
+// Lead converts compatibility jaeum to corresponding lead consonant.
+// Halfwidth jaeum is converted as well.
 func Lead(c rune) rune {
-	if LeadG <= c && c <= LeadH {
+	switch {
+	case leadBase <= c && c < leadFiller:
+		return c
+	case extALeadBase <= c && c <= extALeadEnd:
 		return c
 	}
-	if l, ok := toLead[c]; ok {
-		return l
+	if c = fromHalfwidth(c); c != 0 {
+		return compatTable[c-compatBase].lead
 	}
-
 	return 0
 }
 
-// Medial converts compatibility moeum to corresponding medial vowel
+// Medial converts compatibility moeum to corresponding medial vowel.
+// Halfwidth moeum is converted as well.
 func Medial(c rune) rune {
 	switch {
-	case MedialA <= c && c <= MedialI:
+	case medialFiller < c && c <= oldMedialEnd:
+		return c
+	case extBMedialBase <= c && c <= extBMedialEnd:
 		return c
-	case A <= c && c <= I:
-		return c - A + medialBase
 	}
+	if c = fromHalfwidth(c); c != 0 {
+		return compatTable[c-compatBase].medial
+	}
+	return 0
+}
 
+// Tail converts compatibility jaeum to corresponding tail consonant.
+// Halfwidth jaeum is converted as well.
+func Tail(c rune) rune {
+	switch {
+	case tailBase <= c && c <= oldTailEnd:
+		return c
+	case extBTailBase <= c && c <= extBTailEnd:
+		return c
+	}
+	if c = fromHalfwidth(c); c != 0 {
+		return compatTable[c-compatBase].tail
+	}
 	return 0
 }
 
-var toTail = map[rune]rune{
-	G:  TailG,
-	GG: TailGG,
-	GS: TailGS,
-	N:  TailN,
-	NJ: TailNJ,
-	NH: TailNH,
-	D:  TailD,
-	L:  TailL,
-	LG: TailLG,
-	LM: TailLM,
-	LB: TailLB,
-	LS: TailLS,
-	LT: TailLT,
-	LP: TailLP,
-	LH: TailLH,
-	M:  TailM,
-	B:  TailB,
-	BS: TailBS,
-	S:  TailS,
-	SS: TailSS,
-	ZS: TailNG,
-	J:  TailJ,
-	C:  TailC,
-	K:  TailK,
-	T:  TailT,
-	P:  TailP,
-	H:  TailH,
+// Ranges of lookup tables
+const (
+	compatBase    = G      // HANGUL LETTER KIYEOK
+	compatEnd     = ARAEAE // HANGUL LETTER ARAEAE
+	halfwidthBase = halfwidthFiller
+	halfwidthEnd  = 0xFFDC // HALFWIDTH HANGUL LETTER I
+)
+
+// compatEntry holds properties of a compatibility jamo
+type compatEntry struct {
+	lead, medial, tail rune
+	elements           []rune
+	stroke             int
 }
 
-// Tail converts compatibility jaeum to corresponding tail consonant
-func Tail(c rune) rune {
-	if TailG <= c && c <= TailH {
-		return c
+// compatTable holds conjoining jamo and elements of each compatibility
+// jamo, indexed by offset from compatBase. Strokes and the tables below
+// are built from it.
+var compatTable = [compatEnd - compatBase + 1]compatEntry{
+	G - compatBase:         {lead: LeadG, tail: TailG},
+	GG - compatBase:        {lead: LeadGG, tail: TailGG, elements: []rune{G, G}},
+	GS - compatBase:        {tail: TailGS, elements: []rune{G, S}},
+	N - compatBase:         {lead: LeadN, tail: TailN},
+	NJ - compatBase:        {tail: TailNJ, elements: []rune{N, J}},
+	NH - compatBase:        {tail: TailNH, elements: []rune{N, H}},
+	D - compatBase:         {lead: LeadD, tail: TailD},
+	DD - compatBase:        {lead: LeadDD, elements: []rune{D, D}},
+	L - compatBase:         {lead: LeadR, tail: TailL},
+	LG - compatBase:        {tail: TailLG, elements: []rune{L, G}},
+	LM - compatBase:        {tail: TailLM, elements: []rune{L, M}},
+	LB - compatBase:        {tail: TailLB, elements: []rune{L, B}},
+	LS - compatBase:        {tail: TailLS, elements: []rune{L, S}},
+	LT - compatBase:        {tail: TailLT, elements: []rune{L, T}},
+	LP - compatBase:        {tail: TailLP, elements: []rune{L, P}},
+	LH - compatBase:        {tail: TailLH, elements: []rune{L, H}},
+	M - compatBase:         {lead: LeadM, tail: TailM},
+	B - compatBase:         {lead: LeadB, tail: TailB},
+	BB - compatBase:        {lead: LeadBB, elements: []rune{B, B}},
+	BS - compatBase:        {tail: TailBS, elements: []rune{B, S}},
+	S - compatBase:         {lead: LeadS, tail: TailS},
+	SS - compatBase:        {lead: LeadSS, tail: TailSS, elements: []rune{S, S}},
+	ZS - compatBase:        {lead: LeadZS, tail: TailNG},
+	J - compatBase:         {lead: LeadJ, tail: TailJ},
+	JJ - compatBase:        {lead: LeadJJ, elements: []rune{J, J}},
+	C - compatBase:         {lead: LeadC, tail: TailC},
+	K - compatBase:         {lead: LeadK, tail: TailK},
+	T - compatBase:         {lead: LeadT, tail: TailT},
+	P - compatBase:         {lead: LeadP, tail: TailP},
+	H - compatBase:         {lead: LeadH, tail: TailH},
+	A - compatBase:         {medial: MedialA},
+	AE - compatBase:        {medial: MedialAE, elements: []rune{A, I}},
+	YA - compatBase:        {medial: MedialYA},
+	YAE - compatBase:       {medial: MedialYAE, elements: []rune{YA, I}},
+	EO - compatBase:        {medial: MedialEO},
+	E - compatBase:         {medial: MedialE, elements: []rune{EO, I}},
+	YEO - compatBase:       {medial: MedialYEO},
+	YE - compatBase:        {medial: MedialYE, elements: []rune{YEO, I}},
+	O - compatBase:         {medial: MedialO},
+	WA - compatBase:        {medial: MedialWA, elements: []rune{O, A}},
+	WAE - compatBase:       {medial: MedialWAE, elements: []rune{O, A, I}},
+	OE - compatBase:        {medial: MedialOE, elements: []rune{O, I}},
+	YO - compatBase:        {medial: MedialYO},
+	U - compatBase:         {medial: MedialU},
+	WEO - compatBase:       {medial: MedialWEO, elements: []rune{U, EO}},
+	WE - compatBase:        {medial: MedialWE, elements: []rune{U, E}},
+	WI - compatBase:        {medial: MedialWI, elements: []rune{U, I}},
+	YU - compatBase:        {medial: MedialYU},
+	EU - compatBase:        {medial: MedialEU},
+	YI - compatBase:        {medial: MedialYI, elements: []rune{EU, I}},
+	I - compatBase:         {medial: MedialI},
+	NN - compatBase:        {lead: 0x1114, tail: 0x11FF, elements: []rune{N, N}},
+	ND - compatBase:        {lead: 0x1115, tail: 0x11C6, elements: []rune{N, D}},
+	NS - compatBase:        {lead: 0x115B, tail: 0x11C7, elements: []rune{N, S}},
+	NZ - compatBase:        {tail: 0x11C8, elements: []rune{N, Z}},
+	LGS - compatBase:       {tail: 0x11CC, elements: []rune{L, G, S}},
+	LD - compatBase:        {lead: 0xA966, tail: 0x11CE, elements: []rune{L, D}},
+	LBS - compatBase:       {tail: 0x11D3, elements: []rune{L, B, S}},
+	LZ - compatBase:        {tail: 0x11D7, elements: []rune{L, Z}},
+	LQ - compatBase:        {tail: 0x11D9, elements: []rune{L, Q}},
+	MB - compatBase:        {lead: 0x111C, tail: 0x11DC, elements: []rune{M, B}},
+	MS - compatBase:        {lead: 0xA971, tail: 0x11DD, elements: []rune{M, S}},
+	MZ - compatBase:        {tail: 0x11DF, elements: []rune{M, Z}},
+	MV - compatBase:        {lead: 0x111D, tail: 0x11E2},
+	BG - compatBase:        {lead: 0x111E, elements: []rune{B, G}},
+	BD - compatBase:        {lead: 0x1120, tail: 0xD7E3, elements: []rune{B, D}},
+	BSG - compatBase:       {lead: 0x1122, elements: []rune{B, S, G}},
+	BSD - compatBase:       {lead: 0x1123, tail: 0xD7E7, elements: []rune{B, S, D}},
+	BJ - compatBase:        {lead: 0x1127, tail: 0xD7E8, elements: []rune{B, J}},
+	BT - compatBase:        {lead: 0x1129, elements: []rune{B, T}},
+	BV - compatBase:        {lead: 0x112B, tail: 0x11E6},
+	BBV - compatBase:       {lead: 0x112C},
+	SG - compatBase:        {lead: 0x112D, tail: 0x11E7, elements: []rune{S, G}},
+	SN - compatBase:        {lead: 0x112E, elements: []rune{S, N}},
+	SD - compatBase:        {lead: 0x112F, tail: 0x11E8, elements: []rune{S, D}},
+	SB - compatBase:        {lead: 0x1132, tail: 0x11EA, elements: []rune{S, B}},
+	SJ - compatBase:        {lead: 0x1136, tail: 0xD7EF, elements: []rune{S, J}},
+	Z - compatBase:         {lead: 0x1140, tail: 0x11EB},
+	ZSZS - compatBase:      {lead: 0x1147, tail: 0x11EE, elements: []rune{ZS, ZS}},
+	YESIEUNG - compatBase:  {lead: 0x114C, tail: 0x11F0},
+	YESIEUNGS - compatBase: {tail: 0x11F1, elements: []rune{YESIEUNG, S}},
+	YESIEUNGZ - compatBase: {tail: 0x11F2, elements: []rune{YESIEUNG, Z}},
+	PV - compatBase:        {lead: 0x1157, tail: 0x11F4},
+	HH - compatBase:        {lead: 0x1158, elements: []rune{H, H}},
+	Q - compatBase:         {lead: 0x1159, tail: 0x11F9},
+	YOYA - compatBase:      {medial: 0x1184, elements: []rune{YO, YA}},
+	YOYAE - compatBase:     {medial: 0x1185, elements: []rune{YO, YAE}},
+	YOI - compatBase:       {medial: 0x1188, elements: []rune{YO, I}},
+	YUYEO - compatBase:     {medial: 0x1191, elements: []rune{YU, YEO}},
+	YUYE - compatBase:      {medial: 0x1192, elements: []rune{YU, YE}},
+	YUI - compatBase:       {medial: 0x1194, elements: []rune{YU, I}},
+	ARAEA - compatBase:     {medial: 0x119E},
+	ARAEAE - compatBase:    {medial: 0x11A1, elements: []rune{ARAEA, I}},
+}
+
+// Lookup tables indexed by offset of code point from the start of each
+// range.
+var (
+	jamoToCompat      [oldTailEnd - leadBase + 1]rune
+	extAToCompat      [extALeadEnd - extALeadBase + 1]rune
+	extBToCompat      [extBTailEnd - extBMedialBase + 1]rune
+	halfwidthToCompat [halfwidthEnd - halfwidthBase + 1]rune
+	compatLeads       [LeadH - LeadG + 1]rune
+)
+
+// setCompat sets c as compatibility jamo of conjoining jamo r
+func setCompat(r, c rune) {
+	switch {
+	case r == 0:
+	case r <= oldTailEnd:
+		jamoToCompat[r-leadBase] = c
+	case r <= extALeadEnd:
+		extAToCompat[r-extALeadBase] = c
+	default:
+		extBToCompat[r-extBMedialBase] = c
 	}
-	if t, ok := toTail[c]; ok {
-		return t
+}
+
+func init() {
+	for i := range compatTable {
+		c, e := compatBase+rune(i), &compatTable[i]
+		setCompat(e.lead, c)
+		setCompat(e.medial, c)
+		setCompat(e.tail, c)
+		if e.elements == nil {
+			e.stroke = strokes[c]
+		}
+		for _, es := range e.elements {
+			e.stroke += strokes[es]
+		}
 	}
+	for i := range compatLeads {
+		compatLeads[i] = jamoToCompat[LeadG+i-leadBase]
+	}
+	for r := rune(halfwidthBase); r <= halfwidthEnd; r++ {
+		if c := ToFullwidth(r); c != compatFiller {
+			halfwidthToCompat[r-halfwidthBase] = c
+		}
+	}
+}
 
+// fromHalfwidth returns compatibility jamo of r if r is a compatibility
+// or halfwidth jamo. It returns 0 otherwise.
+func fromHalfwidth(r rune) rune {
+	switch {
+	case compatBase <= r && r <= compatEnd && r != compatFiller:
+		return r
+	case halfwidthBase <= r && r <= halfwidthEnd:
+		return halfwidthToCompat[r-halfwidthBase]
+	}
 	return 0
 }
 
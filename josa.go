@@ -0,0 +1,240 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/suapapa/go_hangul/hanja"
+)
+
+// Paired postpositions(조사); one for words end with consonant and the
+// other for words end with vowel.
+var josaPairs = [][2]string{
+	{"은", "는"},
+	{"이", "가"},
+	{"을", "를"},
+	{"과", "와"},
+	{"으로", "로"},
+	{"으로서", "로서"},
+	{"으로써", "로써"},
+	{"으로부터", "로부터"},
+	{"이랑", "랑"},
+	{"이나", "나"},
+	{"이나마", "나마"},
+	{"이여", "여"},
+	{"아", "야"},
+	{"이야", "야"},
+	{"이에요", "예요"},
+	{"이었", "였"},
+	{"이었다", "였다"},
+	{"이다", "다"},
+	{"이며", "며"},
+	{"이든", "든"},
+	{"이든지", "든지"},
+	{"이라도", "라도"},
+	{"이라고", "라고"},
+	{"이라서", "라서"},
+	{"이면", "면"},
+}
+
+// findJosa returns the pair of given postposition. A postposition can be
+// given in one of the forms, or a combined notation; "이/가", "(이)가",
+// "은(는)", "(으)로".
+func findJosa(josa string) (with, without string, ok bool) {
+	var forms []string
+	switch {
+	case strings.Contains(josa, "/"):
+		forms = strings.SplitN(josa, "/", 2)
+	case strings.HasPrefix(josa, "("):
+		i := strings.Index(josa, ")")
+		if i < 0 {
+			return "", "", false
+		}
+		a, b := josa[1:i], josa[i+1:]
+		// "(으)로" is 으로/로 but "(이)가" is 이/가
+		forms = []string{a + b, b, a}
+	case strings.HasSuffix(josa, ")"):
+		i := strings.Index(josa, "(")
+		if i < 0 {
+			return "", "", false
+		}
+		forms = []string{josa[:i], josa[i+1 : len(josa)-1]}
+	default:
+		forms = []string{josa}
+	}
+
+	for _, p := range josaPairs {
+		for _, f := range forms {
+			if f != p[0] && f != p[1] {
+				continue
+			}
+			for _, g := range forms {
+				if g != f && (g == p[0] || g == p[1]) {
+					return p[0], p[1], true
+				}
+			}
+			if len(forms) == 1 {
+				return p[0], p[1], true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// SelectJosa returns the postposition(조사) suitable for word. Josa can be
+// given in one of the forms, like "이" or "가", or a combined notation,
+// like "이/가", "(이)가" or "은(는)". Josa is returned as is if it is
+// unknown. Words end with digits, Latin letters or hanja are handled by
+// how they are read; "3" as 삼, "Apple" as 애플.
+func SelectJosa(word, josa string) string {
+	with, without, ok := findJosa(josa)
+	if !ok {
+		return josa
+	}
+
+	t := readTail(word)
+	if strings.HasPrefix(with, "으로") && t == TailL {
+		return without // 로 after ㄹ
+	}
+	if t != 0 {
+		return with
+	}
+	return without
+}
+
+// Josa returns word with postposition(조사) suitable for it.
+// See SelectJosa for the details.
+func Josa(word, josa string) string {
+	return word + SelectJosa(word, josa)
+}
+
+// Readings of Latin letters; A as 에이
+var letterReadings = map[rune]rune{
+	'a': '이', 'b': '비', 'c': '씨', 'd': '디', 'e': '이', 'f': '프',
+	'g': '지', 'h': '치', 'i': '이', 'j': '이', 'k': '이', 'l': '엘',
+	'm': '엠', 'n': '엔', 'o': '오', 'p': '피', 'q': '큐', 'r': '알',
+	's': '스', 't': '티', 'u': '유', 'v': '이', 'w': '유', 'x': '스',
+	'y': '이', 'z': '지',
+}
+
+// readTail returns the tail consonant of the last syllable of word
+// as it is read. It returns 0 if the word ends with a vowel sound.
+func readTail(word string) rune {
+	r, _ := utf8.DecodeLastRuneInString(word)
+	switch {
+	case word == "":
+		return 0
+	case isSyllable(r):
+		_, _, t := Split(r)
+		return t
+	case hanja.IsHanja(r):
+		_, _, t := Split([]rune(hanja.Convert(string(r)))[0])
+		return t
+	case '0' <= r && r <= '9':
+		return tailOf(readNumber(word))
+	case IsJaeum(r):
+		// Names of consonants end with the consonant; 기역, 리을
+		if CompatJamo(r) == L {
+			return TailL
+		}
+		return TailG
+	case r < utf8.RuneSelf && unicode.IsLetter(r):
+		return tailOf(readLatin(word))
+	}
+	return 0
+}
+
+func tailOf(r rune) rune {
+	if !isSyllable(r) {
+		return 0
+	}
+	_, _, t := Split(r)
+	return t
+}
+
+// Last syllables of Sino-Korean digits, units in a group of four digits
+// and units of each group; 만 for 10^4, 억 for 10^8 and so on.
+var (
+	sinoDigitSyllables = []rune("영일이삼사오육칠팔구")
+	sinoSmallUnits     = []rune("일십백천")
+	sinoBigUnits       = []rune("일만억조경해자양구간정재극")
+)
+
+// readNumber returns the last syllable of trailing number of word as it
+// is read in Sino-Korean; 10 as 십, 3.14 as 삼 점 일사.
+func readNumber(word string) rune {
+	start := len(word)
+	for start > 0 && ('0' <= word[start-1] && word[start-1] <= '9' || word[start-1] == '.') {
+		start--
+	}
+	num := strings.Trim(word[start:], ".")
+	last := sinoDigitSyllables[num[len(num)-1]-'0']
+	if strings.IndexByte(num, '.') >= 0 {
+		// Digits of fraction are read one by one. Others like 1.2.3 are
+		// not numbers; read the last digit.
+		return last
+	}
+
+	num = strings.TrimLeft(num, "0")
+	i := strings.LastIndexFunc(num, func(r rune) bool { return r != '0' })
+	if i < 0 {
+		return sinoDigitSyllables[0]
+	}
+	zeros := len(num) - 1 - i // trailing zeros
+	switch {
+	case len(num) > 4*len(sinoBigUnits):
+		return last // too large to read; the last digit
+	case zeros == 0:
+		return last
+	case zeros%4 != 0:
+		return sinoSmallUnits[zeros%4] // 십, 백, 천
+	}
+	return sinoBigUnits[zeros/4]
+}
+
+// readLatin guesses the last syllable of trailing Latin word as it is
+// transcribed in Hangul. Acronyms are read letter by letter; "IBM" as
+// 아이비엠. Others are guessed by their endings; "Apple" as 애플.
+func readLatin(word string) rune {
+	start := len(word)
+	for start > 0 && isLatin(word[start-1]) {
+		start--
+	}
+	w := word[start:]
+
+	if w == strings.ToUpper(w) {
+		return letterReadings[unicode.ToLower(rune(w[len(w)-1]))]
+	}
+
+	w = strings.ToLower(w)
+	last := w[len(w)-1]
+	switch {
+	case strings.HasSuffix(w, "ng"):
+		return '잉'
+	case last == 'l' || strings.HasSuffix(w, "le"):
+		return '플' // ㄹ
+	case last == 'm':
+		return '엠'
+	case last == 'n':
+		return '엔'
+	case last == 'k' || last == 'p' || last == 't':
+		// A short vowel followed by k, p, t makes a tail; "book" as 북,
+		// "hot" as 핫, but "desk" as 데스크.
+		if len(w) > 1 && strings.IndexByte("aeiou", w[len(w)-2]) >= 0 {
+			return '북'
+		}
+		if strings.HasSuffix(w, "ck") {
+			return '북'
+		}
+	}
+	return '아'
+}
+
+func isLatin(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
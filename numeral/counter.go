@@ -0,0 +1,96 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package numeral
+
+import (
+	"sync"
+
+	hangul "github.com/suapapa/go_hangul"
+)
+
+// Counter describes how numbers are read with a counter word
+// (단위성 의존명사); 세 개, 삼 분.
+type Counter struct {
+	// Native is true if the counter takes native Korean numbers.
+	// Hundreds and above are read in Sino-Korean anyway; 백스무 개.
+	Native bool
+	// Irregular readings of the counter with some numbers;
+	// 유월 for 6 with 월.
+	Irregular map[int64]string
+}
+
+var (
+	countersMu sync.RWMutex
+	counters   = map[string]Counter{
+		// Native Korean
+		"개":  {Native: true},
+		"명":  {Native: true},
+		"살":  {Native: true},
+		"시":  {Native: true},
+		"시간": {Native: true},
+		"권":  {Native: true},
+		"마리": {Native: true},
+		"번":  {Native: true},
+		"대":  {Native: true},
+		"잔":  {Native: true},
+		"병":  {Native: true},
+		"장":  {Native: true},
+		"달":  {Native: true},
+		"그릇": {Native: true},
+		"사람": {Native: true},
+		// Sino-Korean
+		"분": {},
+		"초": {},
+		"원": {},
+		"년": {},
+		"일": {},
+		"층": {},
+		"세": {},
+		"월": {Irregular: map[int64]string{6: "유월", 10: "시월"}},
+	}
+)
+
+// RegisterCounter registers counter word with how numbers are read with
+// it. Built-in counters can be overridden.
+func RegisterCounter(word string, c Counter) {
+	countersMu.Lock()
+	defer countersMu.Unlock()
+	counters[word] = c
+}
+
+// Count spells n with counter word; Count(3, "개") is "세 개" and
+// Count(3, "분") is "삼 분". Unknown counters take Sino-Korean numbers.
+// See RegisterCounter to add counters.
+func Count(n int64, word string) string {
+	countersMu.RLock()
+	c := counters[word]
+	countersMu.RUnlock()
+
+	if s, ok := c.Irregular[n]; ok {
+		return s
+	}
+	if c.Native {
+		return NativeModifier(n) + " " + word
+	}
+	return SinoKorean(n) + " " + word
+}
+
+// contract contracts a native Korean number to modify a counter;
+// 하나 to 한, 둘 to 두, 스물 to 스무. The tail of the last syllable is
+// dropped, or the last syllable without tail is merged into the previous
+// one as its tail.
+func contract(s string) string {
+	rs := []rune(s)
+	last := len(rs) - 1
+	l, m, t := hangul.Split(rs[last])
+	if t != 0 {
+		rs[last] = hangul.Join(l, m, 0)
+		return string(rs)
+	}
+	// 하나 to 한; ㄴ of 나 to the tail of 하
+	pl, pm, _ := hangul.Split(rs[last-1])
+	rs[last-1] = hangul.Join(pl, pm, hangul.Tail(hangul.CompatJamo(l)))
+	return string(rs[:last])
+}
@@ -0,0 +1,219 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package numeral spells numbers in Hangul and parses them back;
+// 12345 to 만 이천삼백사십오 in Sino-Korean, 3 to 셋 in native Korean.
+package numeral
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrRange is returned if a number is too large to be spelled or
+	// parsed.
+	ErrRange = errors.New("number out of range")
+	// ErrSyntax is returned if a string is not a valid number.
+	ErrSyntax = errors.New("invalid numeral")
+)
+
+// Sino-Korean digits; 영, 일, 이, 삼...
+var sinoDigits = []string{"영", "일", "이", "삼", "사", "오", "육", "칠", "팔", "구"}
+
+// Units of each digit in a group of four digits
+var smallUnits = []string{"", "십", "백", "천"}
+
+// Units of each group of four digits; 만 is 10^4, 억 is 10^8, and so on.
+var bigUnits = []string{
+	"", "만", "억", "조", "경", "해", "자", "양", "구", "간", "정", "재", "극",
+}
+
+var (
+	bigTenThousand = big.NewInt(10000)
+	// Numbers should be less than 10^52; 극 is 10^48
+	bigLimit = new(big.Int).Exp(bigTenThousand, big.NewInt(int64(len(bigUnits))), nil)
+)
+
+// SinoKorean spells n in Sino-Korean, grouping digits by four;
+// 12345 as "만 이천삼백사십오". Negative numbers are prefixed with "마이너스".
+func SinoKorean(n int64) string {
+	s, _ := SinoKoreanBig(big.NewInt(n))
+	return s
+}
+
+// SinoKoreanBig spells n in Sino-Korean. It returns ErrRange if the
+// absolute value of n is not less than 10^52.
+func SinoKoreanBig(n *big.Int) (string, error) {
+	var sign string
+	if n.Sign() < 0 {
+		sign = "마이너스 "
+	}
+	x := new(big.Int).Abs(n)
+	if x.Cmp(bigLimit) >= 0 {
+		return "", ErrRange
+	}
+	if x.Sign() == 0 {
+		return sinoDigits[0], nil
+	}
+
+	var groups []int
+	g := new(big.Int)
+	for x.Sign() > 0 {
+		x.DivMod(x, bigTenThousand, g)
+		groups = append(groups, int(g.Int64()))
+	}
+
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		switch {
+		case groups[i] == 0:
+			continue
+		case groups[i] == 1 && i == 1:
+			words = append(words, bigUnits[i]) // 만, not 일만
+		default:
+			words = append(words, spellGroup(groups[i])+bigUnits[i])
+		}
+	}
+	return sign + strings.Join(words, " "), nil
+}
+
+// spellGroup spells a group of four digits; 2345 as 이천삼백사십오
+func spellGroup(g int) string {
+	var b strings.Builder
+	for i := len(smallUnits) - 1; i >= 0; i-- {
+		d := g
+		for j := 0; j < i; j++ {
+			d /= 10
+		}
+		d %= 10
+		switch {
+		case d == 0:
+			continue
+		case d == 1 && i > 0:
+			// 십, not 일십
+		default:
+			b.WriteString(sinoDigits[d])
+		}
+		b.WriteString(smallUnits[i])
+	}
+	return b.String()
+}
+
+// SinoKoreanDecimal spells a decimal number given in string in
+// Sino-Korean. Digits of fraction are read one by one; "3.14" as
+// "삼 점 일사".
+func SinoKoreanDecimal(s string) (string, error) {
+	i := strings.IndexByte(s, '.')
+	if i < 0 {
+		i = len(s)
+	}
+	n, ok := new(big.Int).SetString(s[:i], 10)
+	if !ok {
+		return "", ErrSyntax
+	}
+	ret, err := SinoKoreanBig(n)
+	if err != nil {
+		return "", err
+	}
+	if n.Sign() == 0 && strings.HasPrefix(s, "-") {
+		ret = "마이너스 " + ret
+	}
+	if i == len(s) {
+		return ret, nil
+	}
+
+	frac := s[i+1:]
+	if frac == "" {
+		return "", ErrSyntax
+	}
+	var b strings.Builder
+	for _, c := range frac {
+		if c < '0' || '9' < c {
+			return "", ErrSyntax
+		}
+		b.WriteString(sinoDigits[c-'0'])
+	}
+	return ret + " 점 " + b.String(), nil
+}
+
+// SinoKoreanFloat spells f in Sino-Korean with the smallest number of
+// digits necessary to represent it. See SinoKoreanDecimal.
+func SinoKoreanFloat(f float64) (string, error) {
+	return SinoKoreanDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// Native Korean numbers for counting; 하나, 둘, 셋...
+var nativeOnes = []string{"", "하나", "둘", "셋", "넷", "다섯", "여섯", "일곱", "여덟", "아홉"}
+
+// Native Korean numbers modifying a counter; 한 개, 두 개, 세 개...
+var nativeModifierOnes = []string{
+	"", contract("하나"), contract("둘"), contract("셋"), contract("넷"),
+	"다섯", "여섯", "일곱", "여덟", "아홉",
+}
+
+// Native Korean numbers of tens; 열, 스물, 서른...
+var nativeTens = []string{"", "열", "스물", "서른", "마흔", "쉰", "예순", "일흔", "여든", "아흔"}
+
+// native spells n with given ones. Native Korean numbers are only for
+// less than 100. Hundreds and above are spelled in Sino-Korean, as they
+// are read; 123 as 백스물셋.
+func native(n int64, ones []string, modifier bool) string {
+	if n == 0 {
+		return sinoDigits[0]
+	}
+	var sign string
+	u := uint64(n)
+	if n < 0 {
+		// In uint64, as -n overflows for math.MinInt64
+		sign, u = "마이너스 ", -u
+	}
+
+	s := sign
+	if h := u - u%100; h > 0 {
+		hs, _ := SinoKoreanBig(new(big.Int).SetUint64(h))
+		s += hs
+	}
+	r := u % 100
+	if modifier && r == 20 {
+		return s + contract(nativeTens[2]) // 스무 개
+	}
+	return s + nativeTens[r/10] + ones[r%10]
+}
+
+// Native spells n in native Korean for counting; 3 as "셋", 21 as "스물하나".
+// Hundreds and above are spelled in Sino-Korean; 123 as "백스물셋".
+func Native(n int64) string {
+	return native(n, nativeOnes, false)
+}
+
+// NativeModifier spells n in native Korean modifying a counter which
+// follows; 3 as "세", 20 as "스무" for 세 개, 스무 살.
+func NativeModifier(n int64) string {
+	return native(n, nativeModifierOnes, true)
+}
+
+// Ordinal spells n in native Korean ordinal; 1 as "첫째", 2 as "둘째",
+// 12 as "열두째". It returns empty string if n is less than 1.
+func Ordinal(n int64) string {
+	switch {
+	case n < 1:
+		return ""
+	case n == 1:
+		return "첫째"
+	case n == 2:
+		return "둘째"
+	}
+
+	var s string
+	switch n % 10 {
+	case 3, 4:
+		s = Native(n) // 셋째, 넷째
+	default:
+		s = NativeModifier(n) // 열한째, 열두째, 스무째
+	}
+	return s + "째"
+}
@@ -0,0 +1,202 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package numeral
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestSinoKorean(t *testing.T) {
+	cases := []struct {
+		n        int64
+		expected string
+	}{
+		{0, "영"},
+		{1, "일"},
+		{10, "십"},
+		{11, "십일"},
+		{105, "백오"},
+		{1000, "천"},
+		{10000, "만"},
+		{12345, "만 이천삼백사십오"},
+		{100000000, "일억"},
+		{120030000, "일억 이천삼만"},
+		{-15, "마이너스 십오"},
+		{9223372036854775807, "구백이십이경 삼천삼백칠십이조 삼백육십팔억 오천사백칠십칠만 오천팔백칠"},
+	}
+	for _, c := range cases {
+		if actual := SinoKorean(c.n); actual != c.expected {
+			t.Errorf("SinoKorean(%d): expected %s, got %s", c.n, c.expected, actual)
+		}
+	}
+
+	n := pow10(48)
+	if s, err := SinoKoreanBig(n); err != nil || s != "일극" {
+		t.Errorf("SinoKoreanBig(10^48): got %s, %v", s, err)
+	}
+	if _, err := SinoKoreanBig(pow10(52)); err != ErrRange {
+		t.Errorf("SinoKoreanBig(10^52): expected ErrRange, got %v", err)
+	}
+}
+
+func TestSinoKoreanDecimal(t *testing.T) {
+	cases := []struct {
+		s, expected string
+	}{
+		{"3.14", "삼 점 일사"},
+		{"0.05", "영 점 영오"},
+		{"-0.5", "마이너스 영 점 오"},
+		{"1200", "천이백"},
+	}
+	for _, c := range cases {
+		actual, err := SinoKoreanDecimal(c.s)
+		if err != nil || actual != c.expected {
+			t.Errorf("SinoKoreanDecimal(%s): expected %s, got %s, %v", c.s, c.expected, actual, err)
+		}
+	}
+	for _, s := range []string{"", "1.", "1.2.3", "a"} {
+		if _, err := SinoKoreanDecimal(s); err != ErrSyntax {
+			t.Errorf("SinoKoreanDecimal(%q): expected ErrSyntax, got %v", s, err)
+		}
+	}
+	if s, _ := SinoKoreanFloat(2.5); s != "이 점 오" {
+		t.Errorf("SinoKoreanFloat(2.5): got %s", s)
+	}
+}
+
+func TestNative(t *testing.T) {
+	cases := []struct {
+		n                           int64
+		counting, modifier, ordinal string
+	}{
+		{1, "하나", "한", "첫째"},
+		{2, "둘", "두", "둘째"},
+		{3, "셋", "세", "셋째"},
+		{4, "넷", "네", "넷째"},
+		{5, "다섯", "다섯", "다섯째"},
+		{10, "열", "열", "열째"},
+		{11, "열하나", "열한", "열한째"},
+		{12, "열둘", "열두", "열두째"},
+		{20, "스물", "스무", "스무째"},
+		{23, "스물셋", "스물세", "스물셋째"},
+		{99, "아흔아홉", "아흔아홉", "아흔아홉째"},
+		{123, "백스물셋", "백스물세", "백스물셋째"},
+	}
+	for _, c := range cases {
+		if actual := Native(c.n); actual != c.counting {
+			t.Errorf("Native(%d): expected %s, got %s", c.n, c.counting, actual)
+		}
+		if actual := NativeModifier(c.n); actual != c.modifier {
+			t.Errorf("NativeModifier(%d): expected %s, got %s", c.n, c.modifier, actual)
+		}
+		if actual := Ordinal(c.n); actual != c.ordinal {
+			t.Errorf("Ordinal(%d): expected %s, got %s", c.n, c.ordinal, actual)
+		}
+	}
+	// -math.MinInt64 overflows
+	min := "마이너스 " + SinoKorean(math.MaxInt64-7) + "여덟"
+	if actual := Native(math.MinInt64); actual != min {
+		t.Errorf("Native(math.MinInt64): expected %s, got %s", min, actual)
+	}
+	if actual := NativeModifier(math.MinInt64); actual != min {
+		t.Errorf("NativeModifier(math.MinInt64): expected %s, got %s", min, actual)
+	}
+	if actual := Count(math.MinInt64, "개"); actual != min+" 개" {
+		t.Errorf("Count(math.MinInt64, 개): expected %s 개, got %s", min, actual)
+	}
+	if actual := Native(-21); actual != "마이너스 스물하나" {
+		t.Errorf("Native(-21): expected 마이너스 스물하나, got %s", actual)
+	}
+	if Ordinal(0) != "" {
+		t.Errorf("Ordinal(0): expected empty string")
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected int64
+	}{
+		{"영", 0},
+		{"십", 10},
+		{"십구", 19},
+		{"만 이천삼백사십오", 12345},
+		{"일억 이천삼만", 120030000},
+		{"천만", 10000000},
+		{"3만 5천", 35000},
+		{"1,200만", 12000000},
+		{"12,345,678", 12345678},
+		{"스물셋", 23},
+		{"스무", 20},
+		{"열두", 12},
+		{"백스물셋", 123},
+		{"마이너스 십오", -15},
+	}
+	for _, c := range cases {
+		actual, err := Parse(c.s)
+		if err != nil || actual != c.expected {
+			t.Errorf("Parse(%s): expected %d, got %d, %v", c.s, c.expected, actual, err)
+		}
+	}
+
+	for _, s := range []string{"", "일이", "백천", "만억", "사과", "셋넷", ",", "1,", ",1", "1,,000", "1,00", "1234,567"} {
+		if _, err := Parse(s); err != ErrSyntax {
+			t.Errorf("Parse(%q): expected ErrSyntax, got %v", s, err)
+		}
+	}
+	if _, err := Parse("일해"); err != ErrRange {
+		t.Errorf("Parse(일해): expected ErrRange, got %v", err)
+	}
+
+	// 구 as 10^32 after a digit
+	n, err := ParseBig("오구")
+	if err != nil || n.Cmp(new(big.Int).Mul(big.NewInt(5), pow10(32))) != 0 {
+		t.Errorf("ParseBig(오구): got %v, %v", n, err)
+	}
+
+	// Round trip
+	for _, i := range []int64{1, 9, 10, 101, 10001, 9999999, 1234567890123} {
+		if j, err := Parse(SinoKorean(i)); err != nil || i != j {
+			t.Errorf("Parse(SinoKorean(%d)): got %d, %v", i, j, err)
+		}
+		if j, err := Parse(Native(i)); err != nil || i != j {
+			t.Errorf("Parse(Native(%d)): got %d, %v", i, j, err)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	cases := []struct {
+		n              int64
+		word, expected string
+	}{
+		{3, "개", "세 개"},
+		{1, "명", "한 명"},
+		{2, "마리", "두 마리"},
+		{20, "살", "스무 살"},
+		{21, "살", "스물한 살"},
+		{5, "시", "다섯 시"},
+		{30, "분", "삼십 분"},
+		{3, "분", "삼 분"},
+		{10000, "원", "만 원"},
+		{6, "월", "유월"},
+		{10, "월", "시월"},
+		{11, "월", "십일 월"},
+		{120, "권", "백스무 권"},
+		{7, "킬로그램", "칠 킬로그램"},
+	}
+	for _, c := range cases {
+		if actual := Count(c.n, c.word); actual != c.expected {
+			t.Errorf("Count(%d, %s): expected %s, got %s", c.n, c.word, c.expected, actual)
+		}
+	}
+
+	RegisterCounter("판", Counter{Native: true})
+	if s := Count(4, "판"); s != "네 판" {
+		t.Errorf("Count(4, 판): got %s", s)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package numeral
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Kinds of words in numerals
+const (
+	kindDigit  = iota // 일, 이, 삼...
+	kindSmall         // 십, 백, 천
+	kindBig           // 만, 억, 조...
+	kindNative        // 하나, 스물...
+)
+
+type numeralWord struct {
+	s     string
+	kind  int
+	value int // the value or the power of ten of units
+}
+
+// numeralWords are words of numerals, longer ones first for matching
+var numeralWords []numeralWord
+
+func init() {
+	for i, s := range sinoDigits {
+		numeralWords = append(numeralWords, numeralWord{s, kindDigit, i})
+	}
+	numeralWords = append(numeralWords, numeralWord{"공", kindDigit, 0})
+	for i, s := range smallUnits[1:] {
+		numeralWords = append(numeralWords, numeralWord{s, kindSmall, i + 1})
+	}
+	for i, s := range bigUnits[1:] {
+		numeralWords = append(numeralWords, numeralWord{s, kindBig, 4 * (i + 1)})
+	}
+	for _, ones := range [][]string{nativeOnes, nativeModifierOnes} {
+		for i, s := range ones[1:] {
+			numeralWords = append(numeralWords, numeralWord{s, kindNative, i + 1})
+		}
+	}
+	for i, s := range nativeTens[1:] {
+		numeralWords = append(numeralWords, numeralWord{s, kindNative, 10 * (i + 1)})
+	}
+	numeralWords = append(numeralWords, numeralWord{contract(nativeTens[2]), kindNative, 20})
+
+	// 일곱 should be matched before 일
+	for i := 1; i < len(numeralWords); i++ {
+		for j := i; j > 0 && len(numeralWords[j].s) > len(numeralWords[j-1].s); j-- {
+			numeralWords[j], numeralWords[j-1] = numeralWords[j-1], numeralWords[j]
+		}
+	}
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// parseDigits parses Arabic digits, which may be grouped by three with
+// commas; "12,345".
+func parseDigits(s string) (*big.Int, bool) {
+	groups := strings.Split(s, ",")
+	for i, g := range groups {
+		if g == "" || i > 0 && len(g) != 3 || len(groups) > 1 && len(g) > 3 {
+			return nil, false
+		}
+	}
+	return new(big.Int).SetString(strings.Join(groups, ""), 10)
+}
+
+// ParseBig parses a numeral in Hangul, Sino-Korean or native Korean, to
+// a number; "만 이천삼백사십오", "스물셋", "3만 5천". Spaces are ignored.
+func ParseBig(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	neg := false
+	for _, p := range []string{"마이너스", "-"} {
+		if strings.HasPrefix(s, p) {
+			neg = true
+			s = s[len(p):]
+			break
+		}
+	}
+
+	var (
+		total     = new(big.Int)
+		group     = new(big.Int) // sum of current group of four digits
+		cur       *big.Int       // the digit waiting for its unit
+		lastSmall = len(smallUnits)
+		lastBig   = 4 * len(bigUnits)
+		lastTens  bool // native tens waiting for ones; 스물 of 스물셋
+		empty     = true
+	)
+	for s != "" {
+		if s[0] == ' ' {
+			s = s[1:]
+			continue
+		}
+		empty = false
+
+		if '0' <= s[0] && s[0] <= '9' {
+			if cur != nil {
+				return nil, ErrSyntax
+			}
+			n := 0
+			for n < len(s) && ('0' <= s[n] && s[n] <= '9' || s[n] == ',') {
+				n++
+			}
+			var ok bool
+			if cur, ok = parseDigits(s[:n]); !ok {
+				return nil, ErrSyntax
+			}
+			s = s[n:]
+			continue
+		}
+
+		var w *numeralWord
+		for i := range numeralWords {
+			if strings.HasPrefix(s, numeralWords[i].s) {
+				w = &numeralWords[i]
+				break
+			}
+		}
+		if w == nil {
+			return nil, ErrSyntax
+		}
+		s = s[len(w.s):]
+		if w.kind != kindNative {
+			lastTens = false
+		}
+
+		// 구 can be either 9 or 10^32; it is the unit after a digit
+		if w.s == "구" && cur != nil {
+			w = &numeralWord{"구", kindBig, 32}
+		}
+
+		switch w.kind {
+		case kindDigit:
+			if cur != nil {
+				return nil, ErrSyntax
+			}
+			cur = big.NewInt(int64(w.value))
+		case kindSmall:
+			if w.value >= lastSmall {
+				return nil, ErrSyntax
+			}
+			lastSmall = w.value
+			if cur == nil {
+				cur = big.NewInt(1) // 십 for 일십
+			}
+			group.Add(group, cur.Mul(cur, pow10(w.value)))
+			cur = nil
+		case kindBig:
+			if w.value >= lastBig {
+				return nil, ErrSyntax
+			}
+			lastBig = w.value
+			lastSmall = len(smallUnits)
+			if cur != nil {
+				group.Add(group, cur)
+				cur = nil
+			}
+			if group.Sign() == 0 {
+				group.SetInt64(1) // 만 for 일만
+			}
+			total.Add(total, group.Mul(group, pow10(w.value)))
+			group = new(big.Int)
+		case kindNative:
+			if cur != nil || group.Int64()%100 != 0 && (!lastTens || w.value >= 10) {
+				return nil, ErrSyntax
+			}
+			lastTens = w.value >= 10
+			group.Add(group, big.NewInt(int64(w.value)))
+		}
+	}
+	if empty {
+		return nil, ErrSyntax
+	}
+	if cur != nil {
+		group.Add(group, cur)
+	}
+	total.Add(total, group)
+	if neg {
+		total.Neg(total)
+	}
+	return total, nil
+}
+
+// Parse parses a numeral in Hangul to int64. It returns ErrRange if the
+// number overflows int64. See ParseBig.
+func Parse(s string) (int64, error) {
+	n, err := ParseBig(s)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsInt64() {
+		return 0, ErrRange
+	}
+	return n.Int64(), nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isOldLead checks given rune is lead consonant, modern or archaic, or
+// the lead filler
+func isOldLead(r rune) bool {
+	switch Classify(r) {
+	case ConjoiningLead:
+		return true
+	case ArchaicJamo:
+		return unicode.Is(archaicLeadTable, r)
+	case HangulFiller:
+		return r == leadFiller
+	}
+	return false
+}
+
+// isOldMedial checks given rune is medial vowel, modern or archaic, or
+// the medial filler
+func isOldMedial(r rune) bool {
+	switch Classify(r) {
+	case ConjoiningMedial:
+		return true
+	case ArchaicJamo:
+		return unicode.Is(archaicMedialTable, r)
+	case HangulFiller:
+		return r == medialFiller
+	}
+	return false
+}
+
+// isOldTail checks given rune is tail consonant, modern or archaic
+func isOldTail(r rune) bool {
+	switch Classify(r) {
+	case ConjoiningTail:
+		return true
+	case ArchaicJamo:
+		return unicode.Is(archaicTailTable, r)
+	}
+	return false
+}
+
+// Hangul syllable types of Unicode
+const (
+	typeOther = iota
+	typeL
+	typeV
+	typeT
+	typeLV
+	typeLVT
+)
+
+func syllableType(r rune) int {
+	switch {
+	case isOldLead(r):
+		return typeL
+	case isOldMedial(r):
+		return typeV
+	case isOldTail(r):
+		return typeT
+	case isSyllable(r):
+		if (r-0xAC00)%28 == 0 {
+			return typeLV
+		}
+		return typeLVT
+	}
+	return typeOther
+}
+
+// joinable reports whether a rune of type b can follow a rune of type a
+// in a syllable block.
+func joinable(a, b int) bool {
+	switch a {
+	case typeL:
+		return b == typeL || b == typeV || b == typeLV || b == typeLVT
+	case typeV, typeLV:
+		return b == typeV || b == typeT
+	case typeT, typeLVT:
+		return b == typeT
+	}
+	return false
+}
+
+// SegmentBlocks splits s into syllable blocks and other characters.
+// A syllable block is a precomposed syllable, or a sequence of
+// conjoining jamo of the form L+ V+ T*, with optional tone marks;
+// "ᄒᆞᆫ글" to "ᄒᆞᆫ", "글". See Syllables.
+func SegmentBlocks(s string) []string {
+	var ret []string
+	for it := Syllables(s); it.Next(); {
+		ret = append(ret, it.Text())
+	}
+	return ret
+}
+
+// IsSyllableBlock checks given string is a complete syllable block, which
+// has both lead and medial. Modern and archaic jamo in conjoining form,
+// and precomposed syllables followed by conjoining jamo are allowed;
+// "ᄀᆞ", "ᄫᅡ", "간ᇫ".
+func IsSyllableBlock(s string) bool {
+	prev := typeOther
+	for i, r := range s {
+		t := syllableType(r)
+		switch {
+		case i == 0:
+			if t != typeL && t != typeLV && t != typeLVT {
+				return false
+			}
+		case isExtend(r):
+			continue
+		case !joinable(prev, t):
+			return false
+		}
+		prev = t
+	}
+	return prev != typeOther && prev != typeL
+}
+
+// SplitOld splits a syllable block to its leads, medials and tails in
+// conjoining jamo. Precomposed syllables are decomposed, and tone marks
+// are dropped. It returns false if block is not a syllable block.
+func SplitOld(block string) (l, m, t []rune, ok bool) {
+	if !IsSyllableBlock(block) {
+		return nil, nil, nil, false
+	}
+	for _, r := range block {
+		switch syllableType(r) {
+		case typeL:
+			l = append(l, r)
+		case typeV:
+			m = append(m, r)
+		case typeT:
+			t = append(t, r)
+		case typeLV, typeLVT:
+			sl, sm, st := Split(r)
+			l = append(l, sl)
+			m = append(m, sm)
+			if st != 0 {
+				t = append(t, st)
+			}
+		}
+	}
+	return l, m, t, true
+}
+
+// JoinOld joins leads, medials and tails, either in conjoining or in
+// compatibility jamo, to a syllable block. It returns a precomposed
+// syllable if possible, or a sequence of conjoining jamo; ㄱ, ㅏ to "가"
+// but ㄱ, ㆍ to "ᄀᆞ". Empty lead or medial is filled with filler. It
+// returns "�" if there is a rune which can not be the part.
+func JoinOld(l, m, t []rune) string {
+	if len(l) == 1 && len(m) == 1 && len(t) <= 1 {
+		var tail rune
+		if len(t) == 1 {
+			tail = t[0]
+		}
+		if r := Join(l[0], m[0], tail); r != 0xFFFD {
+			return string(r)
+		}
+	}
+
+	var b strings.Builder
+	write := func(rs []rune, conv func(rune) rune, filler rune) bool {
+		if len(rs) == 0 && filler != 0 {
+			b.WriteRune(filler)
+		}
+		for _, r := range rs {
+			c := conv(r)
+			if c == 0 {
+				return false
+			}
+			b.WriteRune(c)
+		}
+		return true
+	}
+	if !write(l, Lead, leadFiller) ||
+		!write(m, Medial, medialFiller) ||
+		!write(t, Tail, 0) {
+		return string(utf8.RuneError)
+	}
+	return b.String()
+}
@@ -0,0 +1,258 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+// Place is a place of articulation of a consonant
+type Place int
+
+// Places of articulation
+const (
+	Bilabial Place = iota // 입술소리; ㅁ ㅂ ㅃ ㅍ
+	Alveolar              // 잇몸소리; ㄴ ㄷ ㄸ ㅌ ㄹ ㅅ ㅆ
+	Palatal               // 센입천장소리; ㅈ ㅉ ㅊ
+	Velar                 // 여린입천장소리; ㄱ ㄲ ㅋ ㅇ
+	Glottal               // 목청소리; ㅎ
+)
+
+// Manner is a manner of articulation of a consonant
+type Manner int
+
+// Manners of articulation
+const (
+	Plosive   Manner = iota // 파열음; ㄱ ㄲ ㅋ ㄷ ㄸ ㅌ ㅂ ㅃ ㅍ
+	Fricative               // 마찰음; ㅅ ㅆ ㅎ
+	Affricate               // 파찰음; ㅈ ㅉ ㅊ
+	Nasal                   // 비음; ㄴ ㅁ ㅇ
+	Liquid                  // 유음; ㄹ
+)
+
+// Phonation is a three-way contrast of Korean obstruents. Nasals,
+// liquid and ㅎ are Plain.
+type Phonation int
+
+// Phonations
+const (
+	Plain     Phonation = iota // 예사소리; ㄱ ㄷ ㅂ ㅅ ㅈ
+	Tense                      // 된소리; ㄲ ㄸ ㅃ ㅆ ㅉ
+	Aspirated                  // 거센소리; ㅋ ㅌ ㅍ ㅊ
+)
+
+// ConsonantFeature holds phonological features of a consonant
+type ConsonantFeature struct {
+	Place
+	Manner
+	Phonation
+}
+
+var consonantFeatures = map[rune]ConsonantFeature{
+	G:  {Velar, Plosive, Plain},
+	GG: {Velar, Plosive, Tense},
+	K:  {Velar, Plosive, Aspirated},
+	ZS: {Velar, Nasal, Plain},
+	N:  {Alveolar, Nasal, Plain},
+	D:  {Alveolar, Plosive, Plain},
+	DD: {Alveolar, Plosive, Tense},
+	T:  {Alveolar, Plosive, Aspirated},
+	L:  {Alveolar, Liquid, Plain},
+	S:  {Alveolar, Fricative, Plain},
+	SS: {Alveolar, Fricative, Tense},
+	M:  {Bilabial, Nasal, Plain},
+	B:  {Bilabial, Plosive, Plain},
+	BB: {Bilabial, Plosive, Tense},
+	P:  {Bilabial, Plosive, Aspirated},
+	J:  {Palatal, Affricate, Plain},
+	JJ: {Palatal, Affricate, Tense},
+	C:  {Palatal, Affricate, Aspirated},
+	H:  {Glottal, Fricative, Plain},
+}
+
+// ConsonantFeatures returns phonological features of a modern consonant,
+// in any form. It returns false for vowels, clusters like ㄳ, archaic
+// jamo and lead ㅇ which has no sound.
+func ConsonantFeatures(r rune) (ConsonantFeature, bool) {
+	if r == LeadZS {
+		return ConsonantFeature{}, false
+	}
+	f, ok := consonantFeatures[CompatJamo(r)]
+	return f, ok
+}
+
+// Height is a height of tongue for a vowel
+type Height int
+
+// Heights of vowels
+const (
+	High Height = iota // 고모음; ㅣ ㅟ ㅡ ㅜ
+	Mid                // 중모음; ㅔ ㅚ ㅓ ㅗ
+	Low                // 저모음; ㅐ ㅏ
+)
+
+// Backness is a position of tongue for a vowel
+type Backness int
+
+// Backness of vowels
+const (
+	Front Backness = iota // 전설 모음; ㅣ ㅔ ㅐ ㅟ ㅚ
+	Back                  // 후설 모음; ㅡ ㅓ ㅏ ㅜ ㅗ
+)
+
+// Glide is a semivowel of a diphthong
+type Glide int
+
+// Glides
+const (
+	NoGlide Glide = iota // monophthongs
+	GlideY               // ㅑ ㅒ ㅕ ㅖ ㅛ ㅠ
+	GlideW               // ㅘ ㅙ ㅝ ㅞ
+	GlideEU              // ㅢ
+)
+
+// VowelFeature holds phonological features of a vowel. Height, Backness
+// and Rounded of diphthongs are of the vowel after the glide; ㅏ for ㅘ.
+type VowelFeature struct {
+	Height
+	Backness
+	Rounded bool
+	Glide
+}
+
+// ㅚ and ㅟ are monophthongs as in the Standard Pronunciation
+var vowelFeatures = map[rune]VowelFeature{
+	I:   {High, Front, false, NoGlide},
+	WI:  {High, Front, true, NoGlide},
+	EU:  {High, Back, false, NoGlide},
+	U:   {High, Back, true, NoGlide},
+	E:   {Mid, Front, false, NoGlide},
+	OE:  {Mid, Front, true, NoGlide},
+	EO:  {Mid, Back, false, NoGlide},
+	O:   {Mid, Back, true, NoGlide},
+	AE:  {Low, Front, false, NoGlide},
+	A:   {Low, Back, false, NoGlide},
+	YA:  {Low, Back, false, GlideY},
+	YAE: {Low, Front, false, GlideY},
+	YEO: {Mid, Back, false, GlideY},
+	YE:  {Mid, Front, false, GlideY},
+	YO:  {Mid, Back, true, GlideY},
+	YU:  {High, Back, true, GlideY},
+	WA:  {Low, Back, false, GlideW},
+	WAE: {Low, Front, false, GlideW},
+	WEO: {Mid, Back, false, GlideW},
+	WE:  {Mid, Front, false, GlideW},
+	YI:  {High, Front, false, GlideEU},
+}
+
+// VowelFeatures returns phonological features of a modern vowel, in any
+// form. It returns false if r is not a modern vowel.
+func VowelFeatures(r rune) (VowelFeature, bool) {
+	f, ok := vowelFeatures[CompatJamo(r)]
+	return f, ok
+}
+
+// Harmony is a class of vowel harmony(모음 조화)
+type Harmony int
+
+// Classes of vowel harmony
+const (
+	Yang    Harmony = iota // 양성 모음; ㅏ ㅗ and the vowels made with them
+	Yin                    // 음성 모음; ㅓ ㅜ ㅡ and the vowels made with them
+	Neutral                // 중성 모음; ㅣ
+)
+
+var harmonies = map[rune]Harmony{
+	A:     Yang,
+	AE:    Yang,
+	YA:    Yang,
+	YAE:   Yang,
+	O:     Yang,
+	WA:    Yang,
+	WAE:   Yang,
+	OE:    Yang,
+	YO:    Yang,
+	ARAEA: Yang,
+	EO:    Yin,
+	E:     Yin,
+	YEO:   Yin,
+	YE:    Yin,
+	U:     Yin,
+	WEO:   Yin,
+	WE:    Yin,
+	WI:    Yin,
+	YU:    Yin,
+	EU:    Yin,
+	YI:    Yin,
+	I:     Neutral,
+}
+
+// VowelHarmony returns class of vowel harmony of a vowel, in any form.
+// It returns false if r is not a vowel of the harmony.
+func VowelHarmony(r rune) (Harmony, bool) {
+	h, ok := harmonies[CompatJamo(r)]
+	return h, ok
+}
+
+// Representative final sounds(대표음) of tail consonants, as in the
+// Standard Pronunciation
+var representativeTails = map[rune]rune{
+	TailG:  TailG,
+	TailGG: TailG,
+	TailGS: TailG,
+	TailN:  TailN,
+	TailNJ: TailN,
+	TailNH: TailN,
+	TailD:  TailD,
+	TailL:  TailL,
+	TailLG: TailG, // 닭 is 닥
+	TailLM: TailM, // 삶 is 삼
+	TailLB: TailL, // 여덟 is 여덜
+	TailLS: TailL,
+	TailLT: TailL,
+	TailLP: TailB, // 읊 is 읍
+	TailLH: TailL,
+	TailM:  TailM,
+	TailB:  TailB,
+	TailBS: TailB,
+	TailS:  TailD,
+	TailSS: TailD,
+	TailNG: TailNG,
+	TailJ:  TailD,
+	TailC:  TailD,
+	TailK:  TailG,
+	TailT:  TailD,
+	TailP:  TailB,
+	TailH:  TailD,
+}
+
+// RepresentativeTail returns the representative final sound(대표음) of
+// tail consonant t, one of ㄱ ㄴ ㄷ ㄹ ㅁ ㅂ ㅇ in tail consonant; ㅋ to ㄱ,
+// ㅅ to ㄷ and ㄺ to ㄱ. Compatibility jamo is converted as well. It
+// returns 0 if t is not a modern tail consonant.
+func RepresentativeTail(t rune) rune {
+	return representativeTails[Tail(t)]
+}
+
+var (
+	placeNames     = []string{"Bilabial", "Alveolar", "Palatal", "Velar", "Glottal"}
+	mannerNames    = []string{"Plosive", "Fricative", "Affricate", "Nasal", "Liquid"}
+	phonationNames = []string{"Plain", "Tense", "Aspirated"}
+	heightNames    = []string{"High", "Mid", "Low"}
+	backnessNames  = []string{"Front", "Back"}
+	glideNames     = []string{"NoGlide", "GlideY", "GlideW", "GlideEU"}
+	harmonyNames   = []string{"Yang", "Yin", "Neutral"}
+)
+
+func featureName(names []string, i int, typ string) string {
+	if i < 0 || i >= len(names) {
+		return typ + "(?)"
+	}
+	return names[i]
+}
+
+func (p Place) String() string     { return featureName(placeNames, int(p), "Place") }
+func (m Manner) String() string    { return featureName(mannerNames, int(m), "Manner") }
+func (p Phonation) String() string { return featureName(phonationNames, int(p), "Phonation") }
+func (h Height) String() string    { return featureName(heightNames, int(h), "Height") }
+func (b Backness) String() string  { return featureName(backnessNames, int(b), "Backness") }
+func (g Glide) String() string     { return featureName(glideNames, int(g), "Glide") }
+func (h Harmony) String() string   { return featureName(harmonyNames, int(h), "Harmony") }
@@ -0,0 +1,190 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pronounce converts written Hangul to pronounced Hangul following
+// the Standard Pronunciation (표준 발음법); 국물 to 궁물, 같이 to 가치.
+package pronounce
+
+import (
+	"strings"
+
+	hangul "github.com/suapapa/go_hangul"
+)
+
+// Rule is a sound change rule. Rules can be combined as a bit set.
+type Rule uint
+
+// Sound change rules
+const (
+	HDeletion             Rule = 1 << iota // ㅎ 탈락
+	Palatalization                         // 구개음화
+	Liaison                                // 연음
+	Aspiration                             // 격음화
+	Tensification                          // 경음화
+	ClusterSimplification                  // 겹받침 단순화
+	Neutralization                         // 음절의 끝소리 규칙
+	Lateralization                         // 유음화
+	Nasalization                           // 비음화
+
+	AllRules = HDeletion | Palatalization | Liaison | Aspiration |
+		Tensification | ClusterSimplification | Neutralization |
+		Lateralization | Nasalization
+)
+
+var ruleNames = map[Rule]string{
+	HDeletion:             "ㅎ 탈락",
+	Palatalization:        "구개음화",
+	Liaison:               "연음",
+	Aspiration:            "격음화",
+	Tensification:         "경음화",
+	ClusterSimplification: "겹받침 단순화",
+	Neutralization:        "음절의 끝소리 규칙",
+	Lateralization:        "유음화",
+	Nasalization:          "비음화",
+}
+
+func (r Rule) String() string {
+	if n, ok := ruleNames[r]; ok {
+		return n
+	}
+	return "unknown rule"
+}
+
+// Syllable holds lead, medial and tail of a Hangul syllable in conjoining
+// jamo, as returned by hangul.Split. Tail is 0 if the syllable has no tail.
+type Syllable struct {
+	Lead, Medial, Tail rune
+}
+
+func (s Syllable) String() string {
+	return string(hangul.Join(s.Lead, s.Medial, s.Tail))
+}
+
+// Change records a sound change fired
+type Change struct {
+	Rule Rule
+	// Index is rune index in the input of the syllable which tail is
+	// changed by the rule.
+	Index int
+	// Word is the word, a run of Hangul syllables, after the change
+	Word []Syllable
+}
+
+// Jamo returns the word after the change in compatibility jamo
+func (c Change) Jamo() string {
+	var b strings.Builder
+	for _, s := range c.Word {
+		b.WriteRune(hangul.CompatJamo(s.Lead))
+		b.WriteRune(hangul.CompatJamo(s.Medial))
+		if s.Tail != 0 {
+			b.WriteRune(hangul.CompatJamo(s.Tail))
+		}
+	}
+	return b.String()
+}
+
+// Pronouncer applies sound change rules
+type Pronouncer struct {
+	Rules Rule
+}
+
+// New creates a new Pronouncer which applies given rules
+func New(rules Rule) *Pronouncer {
+	return &Pronouncer{Rules: rules}
+}
+
+// Pronounce returns pronounced form of s applying all rules.
+// Characters other than Hangul syllables are left untouched.
+func Pronounce(s string) string {
+	return New(AllRules).Pronounce(s)
+}
+
+// Pronounce returns pronounced form of s.
+func (p *Pronouncer) Pronounce(s string) string {
+	out, _ := p.Trace(s)
+	return out
+}
+
+// Trace returns pronounced form of s with the changes fired in order.
+func (p *Pronouncer) Trace(s string) (string, []Change) {
+	var b strings.Builder
+	var changes []Change
+	var word []Syllable
+	start := 0
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+		out, cs := p.Apply(word)
+		for _, c := range cs {
+			c.Index += start
+			changes = append(changes, c)
+		}
+		for _, s := range out {
+			b.WriteString(s.String())
+		}
+		word = word[:0]
+	}
+
+	i := 0
+	for _, c := range s {
+		if 0xAC00 <= c && c <= 0xD7A3 {
+			if len(word) == 0 {
+				start = i
+			}
+			l, m, t := hangul.Split(c)
+			word = append(word, Syllable{Lead: l, Medial: m, Tail: t})
+		} else {
+			flush()
+			b.WriteRune(c)
+		}
+		i++
+	}
+	flush()
+
+	return b.String(), changes
+}
+
+// Apply applies rules to a word, a run of Hangul syllables, and returns
+// the pronounced word and the changes fired. Index of the changes are
+// syllable index in the word. The given word is not modified.
+func (p *Pronouncer) Apply(word []Syllable) ([]Syllable, []Change) {
+	word = append([]Syllable(nil), word...)
+
+	var changes []Change
+	apply := func(i int, r Rule, f func(cur, next *Syllable) bool) {
+		if p.Rules&r == 0 {
+			return
+		}
+		var next *Syllable
+		if i+1 < len(word) {
+			next = &word[i+1]
+		}
+		if f(&word[i], next) {
+			changes = append(changes, Change{
+				Rule:  r,
+				Index: i,
+				Word:  append([]Syllable(nil), word...),
+			})
+		}
+	}
+
+	for i := range word {
+		if i+1 < len(word) {
+			apply(i, HDeletion, deleteH)
+			apply(i, Palatalization, palatalize)
+			apply(i, Liaison, liaison)
+			apply(i, Aspiration, aspirate)
+			apply(i, Tensification, tensify)
+		}
+		apply(i, ClusterSimplification, simplifyCluster)
+		apply(i, Neutralization, neutralize)
+		if i+1 < len(word) {
+			apply(i, Lateralization, lateralize)
+			apply(i, Nasalization, nasalize)
+		}
+	}
+
+	return word, changes
+}
@@ -0,0 +1,85 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pronounce
+
+import "testing"
+
+func TestPronounce(t *testing.T) {
+	cases := []struct {
+		in, out string
+	}{
+		{"", ""},
+		{"국물", "궁물"},
+		{"같이", "가치"},
+		{"닭이", "달기"},
+		{"신라", "실라"},
+		{"설날", "설랄"},
+		{"굳이", "구지"},
+		{"굳히다", "구치다"},
+		{"좋아", "조아"},
+		{"좋고", "조코"},
+		{"좋소", "조쏘"},
+		{"놓는", "논는"},
+		{"많아", "마나"},
+		{"앉히다", "안치다"},
+		{"국밥", "국빱"},
+		{"닭", "닥"},
+		{"부엌", "부억"},
+		{"넋과", "넉꽈"},
+		{"종로", "종노"},
+		{"독립", "동닙"},
+		{"옷하고", "오타고"},
+		{"꽃이 피었다", "꼬치 피얻따"},
+		{"한국어", "한구거"},
+		{"밟다", "밥따"},
+		{"밟고", "밥꼬"},
+		{"핥다", "할따"},
+		{"넓게", "널께"},
+		{"넓죽하다", "넙쭈카다"},
+		{"여덟과", "여덜과"},
+		{"맑게", "말께"},
+		{"맑다", "막따"},
+		{"닭고기", "닥꼬기"},
+		{"앉고", "안꼬"},
+		{"얹다", "언따"},
+		{"닮고", "담꼬"},
+		{"옮기다", "옴기다"},
+		{"감다", "감따"},
+		{"신다", "신따"},
+		{"한다", "한다"},
+		{"참고", "참고"},
+	}
+	for _, c := range cases {
+		if out := Pronounce(c.in); out != c.out {
+			t.Errorf("%s: expected %s, got %s", c.in, c.out, out)
+		}
+	}
+}
+
+func TestTrace(t *testing.T) {
+	out, changes := New(AllRules).Trace("부엌 국물")
+	if out != "부억 궁물" {
+		t.Fatalf("unexpected %s", out)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %v", changes)
+	}
+	if c := changes[0]; c.Rule != Neutralization || c.Index != 1 || c.Jamo() != "ㅂㅜㅇㅓㄱ" {
+		t.Errorf("unexpected change %v %d %s", c.Rule, c.Index, c.Jamo())
+	}
+	if c := changes[1]; c.Rule != Nasalization || c.Index != 3 || c.Jamo() != "ㄱㅜㅇㅁㅜㄹ" {
+		t.Errorf("unexpected change %v %d %s", c.Rule, c.Index, c.Jamo())
+	}
+}
+
+func TestRules(t *testing.T) {
+	p := New(AllRules &^ Tensification)
+	if out := p.Pronounce("국밥"); out != "국밥" {
+		t.Errorf("expected 국밥, got %s", out)
+	}
+	if Nasalization.String() != "비음화" {
+		t.Errorf("unexpected name %s", Nasalization)
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pronounce
+
+import (
+	hangul "github.com/suapapa/go_hangul"
+)
+
+// Double tails which keep the second element; 닭 is pronounced 닥.
+var keepSecond = map[rune]bool{
+	hangul.TailLG: true,
+	hangul.TailLM: true,
+	hangul.TailLP: true,
+}
+
+// Aspirated leads of ㄱ, ㄷ, ㅂ, ㅈ which meet ㅎ
+var aspirated = map[rune]rune{
+	hangul.LeadG: hangul.LeadK,
+	hangul.LeadD: hangul.LeadT,
+	hangul.LeadB: hangul.LeadP,
+	hangul.LeadJ: hangul.LeadC,
+}
+
+// Tense leads of ㄱ, ㄷ, ㅂ, ㅅ, ㅈ
+var tense = map[rune]rune{
+	hangul.LeadG: hangul.LeadGG,
+	hangul.LeadD: hangul.LeadDD,
+	hangul.LeadB: hangul.LeadBB,
+	hangul.LeadS: hangul.LeadSS,
+	hangul.LeadJ: hangul.LeadJJ,
+}
+
+var nasals = map[rune]rune{
+	hangul.TailG: hangul.TailNG,
+	hangul.TailD: hangul.TailN,
+	hangul.TailB: hangul.TailM,
+}
+
+// Tails with ㅎ and what remains after ㅎ is gone
+var hTails = map[rune]rune{
+	hangul.TailH:  0,
+	hangul.TailNH: hangul.TailN,
+	hangul.TailLH: hangul.TailL,
+}
+
+// tailToLead converts a tail consonant to the lead of same sound
+func tailToLead(t rune) rune {
+	return hangul.Lead(hangul.CompatJamo(t))
+}
+
+// splitTail splits a double tail consonant, like ㄺ, using
+// hangul.SplitMultiElement. ㄲ and ㅆ are not split.
+func splitTail(t rune) (first, second rune, ok bool) {
+	if t == 0 || tailToLead(t) != 0 {
+		return 0, 0, false
+	}
+	es, ok := hangul.SplitMultiElement(t)
+	if !ok {
+		return 0, 0, false
+	}
+	return hangul.Tail(es[0]), hangul.Tail(es[1]), true
+}
+
+// deleteH drops ㅎ before a vowel; 좋아 is 조아.
+func deleteH(cur, next *Syllable) bool {
+	rest, ok := hTails[cur.Tail]
+	if !ok || next.Lead != hangul.LeadZS {
+		return false
+	}
+	cur.Tail = rest
+	return true
+}
+
+// palatalize changes ㄷ, ㅌ before 이 to ㅈ, ㅊ; 굳이 is 구지.
+func palatalize(cur, next *Syllable) bool {
+	if next.Medial != hangul.MedialI {
+		return false
+	}
+	switch {
+	case cur.Tail == hangul.TailD && next.Lead == hangul.LeadZS:
+		cur.Tail, next.Lead = 0, hangul.LeadJ
+	case cur.Tail == hangul.TailT && next.Lead == hangul.LeadZS:
+		cur.Tail, next.Lead = 0, hangul.LeadC
+	case cur.Tail == hangul.TailLT && next.Lead == hangul.LeadZS:
+		cur.Tail, next.Lead = hangul.TailL, hangul.LeadC
+	case cur.Tail == hangul.TailD && next.Lead == hangul.LeadH:
+		cur.Tail, next.Lead = 0, hangul.LeadC // 굳히다 is 구치다
+	default:
+		return false
+	}
+	return true
+}
+
+// liaison moves a tail to the next syllable which starts with a vowel;
+// 국어 is 구거, 닭이 is 달기.
+func liaison(cur, next *Syllable) bool {
+	t := cur.Tail
+	if t == 0 || t == hangul.TailNG || next.Lead != hangul.LeadZS {
+		return false
+	}
+	if f, s, ok := splitTail(t); ok {
+		cur.Tail, next.Lead = f, tailToLead(s)
+	} else {
+		cur.Tail, next.Lead = 0, tailToLead(t)
+	}
+	return true
+}
+
+// aspirate merges ㅎ and ㄱ, ㄷ, ㅂ, ㅈ to ㅋ, ㅌ, ㅍ, ㅊ;
+// 좋고 is 조코, 앉히다 is 안치다.
+func aspirate(cur, next *Syllable) bool {
+	t := cur.Tail
+	if t == 0 {
+		return false
+	}
+
+	if rest, ok := hTails[t]; ok {
+		asp, ok := aspirated[next.Lead]
+		if !ok {
+			return false
+		}
+		cur.Tail, next.Lead = rest, asp
+		return true
+	}
+
+	if next.Lead != hangul.LeadH {
+		return false
+	}
+	var rest rune
+	lead := tailToLead(t)
+	if f, s, ok := splitTail(t); ok {
+		rest, lead = f, tailToLead(s)
+	}
+	asp, ok := aspirated[lead]
+	if !ok {
+		// 옷하고 is 오타고
+		rest, lead = 0, tailToLead(hangul.RepresentativeTail(t))
+		if asp, ok = aspirated[lead]; !ok {
+			return false
+		}
+	}
+	cur.Tail, next.Lead = rest, asp
+	return true
+}
+
+// tensify makes ㄱ, ㄷ, ㅂ, ㅅ, ㅈ tense after ㄱ, ㄷ, ㅂ sounds;
+// 국밥 is 국빱. ㅎ and ㅅ become ㅆ; 좋소 is 조쏘.
+func tensify(cur, next *Syllable) bool {
+	if rest, ok := hTails[cur.Tail]; ok && next.Lead == hangul.LeadS {
+		cur.Tail, next.Lead = rest, hangul.LeadSS
+		return true
+	}
+
+	if !tensifies(cur, next) {
+		return false
+	}
+	t, ok := tense[next.Lead]
+	if !ok {
+		return false
+	}
+	next.Lead = t
+	return true
+}
+
+// Stems end with ㄴ which make the next tense before 다; 신다 is 신따.
+// Others, like 한다 and 안다 of 알다, are not.
+var nStems = map[string]bool{
+	"신": true,
+}
+
+// tensifies reports whether tail of cur makes lead of next tense. The
+// tail is checked before simplified, so ㄼ of 넓게 does as a stem.
+func tensifies(cur, next *Syllable) bool {
+	isDa := next.Lead == hangul.LeadD && next.Medial == hangul.MedialA && next.Tail == 0
+	switch cur.Tail {
+	case hangul.TailLB, hangul.TailLT:
+		// Stems end with ㄼ, ㄾ(제25항); 넓게 is 널께, 핥다 is 할따.
+		// 여덟 is not a stem.
+		return cur.String() != "덟"
+	case hangul.TailNJ, hangul.TailLM:
+		// Stems end with ㄵ, ㄻ(제24항); 앉고 is 안꼬. Not before 기 of
+		// passive and causative; 옮기다 is 옴기다.
+		return !(next.Lead == hangul.LeadG && next.Medial == hangul.MedialI)
+	case hangul.TailM:
+		// Stems end with ㅁ before 다(제24항); 감다 is 감따. 참고 is a
+		// noun, so other endings can not be told.
+		return isDa
+	case hangul.TailN:
+		return isDa && nStems[cur.String()]
+	}
+
+	switch hangul.RepresentativeTail(cur.Tail) {
+	case hangul.TailG, hangul.TailD, hangul.TailB:
+		return true
+	}
+	return false
+}
+
+// simplifyCluster reduces a double tail to one consonant;
+// 닭 is 닥, 넋 is 넉. ㄺ before ㄱ is ㄹ but in nouns; 맑게 is 말께. ㄼ of 밟
+// and of 넓 in 넓죽하다 and 넓둥글다 is ㅂ; 밟다 is 밥따.
+func simplifyCluster(cur, next *Syllable) bool {
+	f, s, ok := splitTail(cur.Tail)
+	if !ok {
+		return false
+	}
+	switch {
+	case cur.Tail == hangul.TailLG && next != nil && !lgNouns[cur.String()] &&
+		(next.Lead == hangul.LeadG || next.Lead == hangul.LeadGG):
+	case cur.Tail == hangul.TailLB && keepB(cur, next):
+		f = s
+	case keepSecond[cur.Tail]:
+		f = s
+	}
+	cur.Tail = f
+	return true
+}
+
+// Nouns end with ㄺ, which is ㄱ before ㄱ; 닭고기 is 닥꼬기
+var lgNouns = map[string]bool{
+	"닭": true,
+	"흙": true,
+	"칡": true,
+	"삵": true,
+}
+
+// keepB reports whether ㄼ of cur is pronounced ㅂ
+func keepB(cur, next *Syllable) bool {
+	switch cur.String() {
+	case "밟":
+		return true
+	case "넓":
+		if next == nil {
+			return false
+		}
+		n := *next // may be made tense already; 넓쭉
+		for plain, t := range tense {
+			if n.Lead == t {
+				n.Lead = plain
+			}
+		}
+		return n.String() == "죽" || n.String() == "둥"
+	}
+	return false
+}
+
+// neutralize reduces a tail to its representative final sound;
+// 부엌 is 부억.
+func neutralize(cur, _ *Syllable) bool {
+	if _, _, ok := splitTail(cur.Tail); ok {
+		return false // left to simplifyCluster
+	}
+	n := hangul.RepresentativeTail(cur.Tail)
+	if n == 0 || n == cur.Tail {
+		return false
+	}
+	cur.Tail = n
+	return true
+}
+
+// lateralize makes ㄴ next to ㄹ to ㄹ; 신라 is 실라, 설날 is 설랄.
+func lateralize(cur, next *Syllable) bool {
+	switch {
+	case cur.Tail == hangul.TailN && next.Lead == hangul.LeadR:
+		cur.Tail = hangul.TailL
+	case cur.Tail == hangul.TailL && next.Lead == hangul.LeadN:
+		next.Lead = hangul.LeadR
+	default:
+		return false
+	}
+	return true
+}
+
+// nasalize makes ㄱ, ㄷ, ㅂ before nasals to ㅇ, ㄴ, ㅁ, and ㄹ after
+// consonants other than ㄹ to ㄴ; 국물 is 궁물, 종로 is 종노.
+func nasalize(cur, next *Syllable) bool {
+	t := hangul.RepresentativeTail(cur.Tail)
+	if t == 0 {
+		return false
+	}
+
+	changed := false
+	if next.Lead == hangul.LeadR && t != hangul.TailL {
+		next.Lead = hangul.LeadN // 독립 is 독닙 then 동닙
+		changed = true
+	}
+	if n, ok := nasals[t]; ok && (next.Lead == hangul.LeadN || next.Lead == hangul.LeadM) {
+		cur.Tail = n
+		changed = true
+	}
+	return changed
+}
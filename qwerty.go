@@ -0,0 +1,113 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import "strings"
+
+var jamoToQwerty = make(map[rune]rune)
+
+func init() {
+	for k, j := range dubeolsikKeys {
+		jamoToQwerty[j] = k
+	}
+}
+
+// ToQwerty converts Hangul in s to QWERTY keys which type it on
+// Dubeolsik keyboard. Multi-element jamo without its own key are
+// expanded to key sequence; ㅘ to "hk", ㄺ to "fr".
+func ToQwerty(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if !isSyllable(r) && CompatJamo(r) == 0 {
+			b.WriteRune(r)
+			continue
+		}
+
+		if !isSyllable(r) {
+			if keys, ok := qwertyKeys(CompatJamo(r)); ok {
+				b.WriteString(keys)
+			} else {
+				b.WriteRune(r) // archaic jamo without keys, like ㆍ
+			}
+			continue
+		}
+		l, m, t := SplitCompat(r)
+		for _, j := range []rune{l, m, t} {
+			keys, _ := qwertyKeys(j)
+			b.WriteString(keys)
+		}
+	}
+	return b.String()
+}
+
+// qwertyKeys returns keys which type compatibility jamo j. It returns
+// false if j or any of its elements has no key.
+func qwertyKeys(j rune) (string, bool) {
+	if k, ok := jamoToQwerty[j]; ok {
+		return string(k), true
+	}
+	es, ok := SplitMultiElement(j)
+	if !ok {
+		return "", false
+	}
+	var keys string
+	for _, e := range es {
+		k, ok := qwertyKeys(e)
+		if !ok {
+			return "", false
+		}
+		keys += k
+	}
+	return keys, true
+}
+
+// FromQwerty converts QWERTY keys in s to Hangul as typed on
+// Dubeolsik keyboard.
+func FromQwerty(s string) string {
+	d := NewDubeolsik()
+	for _, k := range s {
+		d.Process(k)
+	}
+	return d.Flush()
+}
+
+// Jamo commonly used alone in chat, like ㅋㅋ or ㅠㅠ
+var chatJamo = map[rune]bool{K: true, H: true, U: true, YU: true}
+
+// LooksMistyped reports whether s seems to be typed with wrong input mode.
+// It is a heuristic; s is mistyped if it is made of Latin letters that turn
+// into complete Hangul syllables by FromQwerty, or if it is Hangul having
+// standalone vowels which are unusual in Korean text.
+func LooksMistyped(s string) bool {
+	var latin, hangul, others int
+	for _, r := range s {
+		switch {
+		case 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z':
+			latin++
+		case IsHangul(r):
+			hangul++
+		case r == ' ':
+		default:
+			others++
+		}
+	}
+
+	switch {
+	case latin > 0 && hangul == 0 && others == 0:
+		for _, r := range FromQwerty(s) {
+			if r != ' ' && !isSyllable(r) {
+				return false
+			}
+		}
+		return true
+	case hangul > 0 && latin == 0:
+		for _, r := range s {
+			if A <= r && r <= I && !chatJamo[r] {
+				return true
+			}
+		}
+	}
+	return false
+}
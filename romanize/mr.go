@@ -0,0 +1,110 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package romanize
+
+import (
+	"strings"
+
+	hangul "github.com/suapapa/go_hangul"
+)
+
+type mccuneReischauer struct{}
+
+// McCuneReischauer is the scheme of McCune-Reischauer romanization
+// which writes breves and apostrophes; 한국 is han'guk.
+var McCuneReischauer Scheme = mccuneReischauer{}
+
+var mrLeads = map[rune]string{
+	hangul.LeadG:  "k",
+	hangul.LeadGG: "kk",
+	hangul.LeadN:  "n",
+	hangul.LeadD:  "t",
+	hangul.LeadDD: "tt",
+	hangul.LeadR:  "r",
+	hangul.LeadM:  "m",
+	hangul.LeadB:  "p",
+	hangul.LeadBB: "pp",
+	hangul.LeadS:  "s",
+	hangul.LeadSS: "ss",
+	hangul.LeadZS: "",
+	hangul.LeadJ:  "ch",
+	hangul.LeadJJ: "tch",
+	hangul.LeadC:  "ch'",
+	hangul.LeadK:  "k'",
+	hangul.LeadT:  "t'",
+	hangul.LeadP:  "p'",
+	hangul.LeadH:  "h",
+}
+
+// Plain consonants are voiced between voiced sounds
+var mrVoicedLeads = map[rune]string{
+	hangul.LeadG: "g",
+	hangul.LeadD: "d",
+	hangul.LeadB: "b",
+	hangul.LeadJ: "j",
+}
+
+var mrMedials = map[rune]string{
+	hangul.MedialA:   "a",
+	hangul.MedialAE:  "ae",
+	hangul.MedialYA:  "ya",
+	hangul.MedialYAE: "yae",
+	hangul.MedialEO:  "ŏ",
+	hangul.MedialE:   "e",
+	hangul.MedialYEO: "yŏ",
+	hangul.MedialYE:  "ye",
+	hangul.MedialO:   "o",
+	hangul.MedialWA:  "wa",
+	hangul.MedialWAE: "wae",
+	hangul.MedialOE:  "oe",
+	hangul.MedialYO:  "yo",
+	hangul.MedialU:   "u",
+	hangul.MedialWEO: "wŏ",
+	hangul.MedialWE:  "we",
+	hangul.MedialWI:  "wi",
+	hangul.MedialYU:  "yu",
+	hangul.MedialEU:  "ŭ",
+	hangul.MedialYI:  "ŭi",
+	hangul.MedialI:   "i",
+}
+
+// voiced reports whether lead after tail t is voiced; after a vowel or
+// a nasal or liquid tail.
+func voiced(t rune) bool {
+	if t == 0 {
+		return true
+	}
+	f, ok := hangul.ConsonantFeatures(t)
+	return ok && (f.Manner == hangul.Nasal || f.Manner == hangul.Liquid)
+}
+
+func (mccuneReischauer) RomanizeWord(word []Syllable) string {
+	word = ApplySoundChanges(word)
+
+	var b strings.Builder
+	for i, s := range word {
+		lead := mrLeads[s.Lead]
+		if i > 0 {
+			prev := word[i-1].Tail
+			if v, ok := mrVoicedLeads[s.Lead]; ok && voiced(prev) {
+				lead = v
+			}
+			switch {
+			case s.Lead == hangul.LeadR && prev == hangul.TailL:
+				lead = "l" // 신라 is silla
+			case s.Lead == hangul.LeadG && prev == hangul.TailN:
+				b.WriteString("'") // 한국 is han'guk, not hanguk
+			}
+		}
+		if s.Lead == hangul.LeadS && (s.Medial == hangul.MedialI || s.Medial == hangul.MedialWI) {
+			lead = "sh"
+		}
+
+		b.WriteString(lead)
+		b.WriteString(mrMedials[s.Medial])
+		b.WriteString(tails[hangul.RepresentativeTail(s.Tail)])
+	}
+	return b.String()
+}
@@ -0,0 +1,279 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package romanize converts Hangul to Latin script. Revised Romanization
+// of Korean (국어의 로마자 표기법), McCune-Reischauer and Yale romanization
+// are provided, and other schemes can be plugged in by Scheme interface.
+package romanize
+
+import (
+	"strings"
+
+	hangul "github.com/suapapa/go_hangul"
+)
+
+// Mode selects how Hangul is romanized in Revised Romanization
+type Mode int
+
+const (
+	// Pronunciation romanizes Hangul as it is pronounced, applying
+	// sound changes between syllables.
+	Pronunciation Mode = iota
+	// Transliteration maps each jamo one-to-one, so the output can be
+	// converted back to Hangul.
+	Transliteration
+)
+
+// Romanizer converts Hangul to Latin script
+type Romanizer struct {
+	Mode Mode
+	// Scheme overrides Mode if it is not nil
+	Scheme Scheme
+}
+
+// New creates a new Romanizer with given mode
+func New(mode Mode) *Romanizer {
+	return &Romanizer{Mode: mode}
+}
+
+// NewWithScheme creates a new Romanizer with given scheme
+func NewWithScheme(scheme Scheme) *Romanizer {
+	return &Romanizer{Scheme: scheme}
+}
+
+// Romanize returns s in Revised Romanization as it is pronounced
+func Romanize(s string) string {
+	return New(Pronunciation).Romanize(s)
+}
+
+// Transliterate returns s in Revised Romanization, jamo by jamo
+func Transliterate(s string) string {
+	return New(Transliteration).Romanize(s)
+}
+
+func (r *Romanizer) scheme() Scheme {
+	switch {
+	case r.Scheme != nil:
+		return r.Scheme
+	case r.Mode == Transliteration:
+		return RevisedTransliteration
+	}
+	return RevisedRomanization
+}
+
+// Romanize returns s in Latin script. Characters other than Hangul
+// syllables are left untouched.
+func (r *Romanizer) Romanize(s string) string {
+	scheme := r.scheme()
+
+	var b strings.Builder
+	var word []Syllable
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+		b.WriteString(scheme.RomanizeWord(word))
+		word = word[:0]
+	}
+
+	for _, c := range s {
+		if 0xAC00 <= c && c <= 0xD7A3 {
+			l, m, t := hangul.Split(c)
+			word = append(word, Syllable{Lead: l, Medial: m, Tail: t})
+			continue
+		}
+		flush()
+		b.WriteRune(c)
+	}
+	flush()
+
+	return b.String()
+}
+
+var leads = map[rune]string{
+	hangul.LeadG:  "g",
+	hangul.LeadGG: "kk",
+	hangul.LeadN:  "n",
+	hangul.LeadD:  "d",
+	hangul.LeadDD: "tt",
+	hangul.LeadR:  "r",
+	hangul.LeadM:  "m",
+	hangul.LeadB:  "b",
+	hangul.LeadBB: "pp",
+	hangul.LeadS:  "s",
+	hangul.LeadSS: "ss",
+	hangul.LeadZS: "",
+	hangul.LeadJ:  "j",
+	hangul.LeadJJ: "jj",
+	hangul.LeadC:  "ch",
+	hangul.LeadK:  "k",
+	hangul.LeadT:  "t",
+	hangul.LeadP:  "p",
+	hangul.LeadH:  "h",
+}
+
+var medials = map[rune]string{
+	hangul.MedialA:   "a",
+	hangul.MedialAE:  "ae",
+	hangul.MedialYA:  "ya",
+	hangul.MedialYAE: "yae",
+	hangul.MedialEO:  "eo",
+	hangul.MedialE:   "e",
+	hangul.MedialYEO: "yeo",
+	hangul.MedialYE:  "ye",
+	hangul.MedialO:   "o",
+	hangul.MedialWA:  "wa",
+	hangul.MedialWAE: "wae",
+	hangul.MedialOE:  "oe",
+	hangul.MedialYO:  "yo",
+	hangul.MedialU:   "u",
+	hangul.MedialWEO: "wo",
+	hangul.MedialWE:  "we",
+	hangul.MedialWI:  "wi",
+	hangul.MedialYU:  "yu",
+	hangul.MedialEU:  "eu",
+	hangul.MedialYI:  "ui",
+	hangul.MedialI:   "i",
+}
+
+// Romanization of the 7 representative final sounds
+var tails = map[rune]string{
+	hangul.TailG:  "k",
+	hangul.TailN:  "n",
+	hangul.TailD:  "t",
+	hangul.TailL:  "l",
+	hangul.TailM:  "m",
+	hangul.TailB:  "p",
+	hangul.TailNG: "ng",
+}
+
+type revisedRomanization struct{}
+
+// RevisedRomanization is the scheme of Revised Romanization of Korean
+// which reflects sound changes.
+var RevisedRomanization Scheme = revisedRomanization{}
+
+func (revisedRomanization) RomanizeWord(word []Syllable) string {
+	word = ApplySoundChanges(word)
+
+	var b strings.Builder
+	for i, s := range word {
+		if s.Lead == hangul.LeadR && i > 0 && word[i-1].Tail == hangul.TailL {
+			// ㄹㄹ is romanized as ll
+			b.WriteString("l")
+		} else {
+			b.WriteString(leads[s.Lead])
+		}
+		b.WriteString(medials[s.Medial])
+		b.WriteString(tails[hangul.RepresentativeTail(s.Tail)])
+	}
+	return b.String()
+}
+
+var translitLeads = map[rune]string{
+	hangul.LeadR: "l",
+}
+
+var translitTails = map[rune]string{
+	hangul.TailG:  "g",
+	hangul.TailGG: "kk",
+	hangul.TailGS: "gs",
+	hangul.TailN:  "n",
+	hangul.TailNJ: "nj",
+	hangul.TailNH: "nh",
+	hangul.TailD:  "d",
+	hangul.TailL:  "l",
+	hangul.TailLG: "lg",
+	hangul.TailLM: "lm",
+	hangul.TailLB: "lb",
+	hangul.TailLS: "ls",
+	hangul.TailLT: "lt",
+	hangul.TailLP: "lp",
+	hangul.TailLH: "lh",
+	hangul.TailM:  "m",
+	hangul.TailB:  "b",
+	hangul.TailBS: "bs",
+	hangul.TailS:  "s",
+	hangul.TailSS: "ss",
+	hangul.TailNG: "ng",
+	hangul.TailJ:  "j",
+	hangul.TailC:  "ch",
+	hangul.TailK:  "k",
+	hangul.TailT:  "t",
+	hangul.TailP:  "p",
+	hangul.TailH:  "h",
+}
+
+func translitLead(l rune) string {
+	if s, ok := translitLeads[l]; ok {
+		return s
+	}
+	return leads[l]
+}
+
+type revisedTransliteration struct{}
+
+// RevisedTransliteration is the scheme of Revised Romanization of Korean
+// which maps each jamo one-to-one.
+var RevisedTransliteration Scheme = revisedTransliteration{}
+
+func (revisedTransliteration) RomanizeWord(word []Syllable) string {
+	var b strings.Builder
+	for i, s := range word {
+		lead := translitLead(s.Lead)
+		if i > 0 {
+			tail := translitTails[word[i-1].Tail]
+			// Hyphenate where the boundary of syllables is ambiguous;
+			// 없었습니다 is eobs-eoss-seubnida.
+			if s.Lead == hangul.LeadZS || ambiguous(tail, lead) {
+				b.WriteString("-")
+			}
+		}
+		b.WriteString(lead)
+		b.WriteString(medials[s.Medial])
+		b.WriteString(translitTails[s.Tail])
+	}
+	return b.String()
+}
+
+// ambiguous reports whether tail+lead can be split in other tail and lead.
+func ambiguous(tail, lead string) bool {
+	if tail == "" || lead == "" {
+		return false
+	}
+	tl := tail + lead
+	for i := 0; i <= len(tl); i++ {
+		if i == len(tail) {
+			continue
+		}
+		if isTranslitTail(tl[:i]) && isTranslitLead(tl[i:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTranslitTail(s string) bool {
+	if s == "" {
+		return true
+	}
+	for _, t := range translitTails {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isTranslitLead(s string) bool {
+	if s == "" {
+		return false // silent ㅇ is always hyphenated
+	}
+	for l := hangul.LeadG; l <= hangul.LeadH; l++ {
+		if translitLead(rune(l)) == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package romanize
+
+import "testing"
+
+func TestRomanize(t *testing.T) {
+	cases := []struct {
+		in, out string
+	}{
+		{"", ""},
+		{"한글", "hangeul"},
+		{"서울", "seoul"},
+		{"부산", "busan"},
+		{"백마", "baengma"},
+		{"종로", "jongno"},
+		{"왕십리", "wangsimni"},
+		{"신라", "silla"},
+		{"별내", "byeollae"},
+		{"옷하고", "otago"},
+		{"앉히다", "anchida"},
+		{"싫어", "sireo"},
+		{"해돋이", "haedoji"},
+		{"같이", "gachi"},
+		{"굳히다", "guchida"},
+		{"좋고", "joko"},
+		{"놓다", "nota"},
+		{"잡혀", "japyeo"},
+		{"닭이", "dalgi"},
+		{"밟다", "bapda"},
+		{"맑게", "malge"},
+		{"많아", "mana"},
+		{"좋아", "joa"},
+		{"국물", "gungmul"},
+		{"독립", "dongnip"},
+		{"울릉", "ulleung"},
+		{"의정부", "uijeongbu"},
+		{"대관령", "daegwallyeong"},
+		{"압구정", "apgujeong"},
+		{"한국 2024!", "hanguk 2024!"},
+	}
+	for _, c := range cases {
+		if out := Romanize(c.in); out != c.out {
+			t.Errorf("Romanize(%s): expected %s, got %s", c.in, c.out, out)
+		}
+	}
+}
+
+func TestTransliterate(t *testing.T) {
+	cases := []struct {
+		in, out string
+	}{
+		{"집", "jib"},
+		{"짚", "jip"},
+		{"밖", "bakk"},
+		{"값", "gabs"},
+		{"붓꽃", "buskkoch"},
+		{"먹는", "meogneun"},
+		{"독립", "doglib"},
+		{"문리", "munli"},
+		{"물엿", "mul-yeos"},
+		{"굳이", "gud-i"},
+		{"좋다", "johda"},
+		{"가곡", "gagog"},
+		{"조랑말", "jolangmal"},
+		{"없었습니다", "eobs-eoss-seubnida"},
+	}
+	for _, c := range cases {
+		if out := Transliterate(c.in); out != c.out {
+			t.Errorf("Transliterate(%s): expected %s, got %s", c.in, c.out, out)
+		}
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	for c := rune(0xAC00); c <= 0xD7A3; c++ {
+		if Romanize(string(c)) == "" {
+			t.Errorf("%c is not romanized", c)
+		}
+		if Transliterate(string(c)) == "" {
+			t.Errorf("%c is not transliterated", c)
+		}
+	}
+}
+
+func TestMcCuneReischauer(t *testing.T) {
+	cases := []struct {
+		in, out string
+	}{
+		{"한국", "han'guk"},
+		{"한글", "han'gŭl"},
+		{"부산", "pusan"},
+		{"서울", "sŏul"},
+		{"대구", "taegu"},
+		{"인천", "inch'ŏn"},
+		{"광주", "kwangju"},
+		{"제주", "cheju"},
+		{"청주", "ch'ŏngju"},
+		{"평양", "p'yŏngyang"},
+		{"천리", "ch'ŏlli"},
+		{"신촌", "shinch'on"},
+		{"독립문", "tongnimmun"},
+		{"김치", "kimch'i"},
+		{"시장", "shijang"},
+		{"금강산", "kŭmgangsan"},
+		{"백두산", "paektusan"},
+		{"대관령", "taegwallyŏng"},
+	}
+	r := NewWithScheme(McCuneReischauer)
+	for _, c := range cases {
+		if out := r.Romanize(c.in); out != c.out {
+			t.Errorf("%s: expected %s, got %s", c.in, c.out, out)
+		}
+	}
+}
+
+func TestYale(t *testing.T) {
+	cases := []struct {
+		in, out string
+	}{
+		{"한국", "hankwuk"},
+		{"한글", "hankul"},
+		{"서울", "sewul"},
+		{"물", "mul"},
+		{"말", "mal"},
+		{"김치", "kimchi"},
+		{"좋다", "cohta"},
+		{"꽃", "kkoch"},
+		{"닭", "talk"},
+		{"안아", "an.a"},
+		{"의사", "uysa"},
+	}
+	r := NewWithScheme(Yale)
+	for _, c := range cases {
+		if out := r.Romanize(c.in); out != c.out {
+			t.Errorf("%s: expected %s, got %s", c.in, c.out, out)
+		}
+	}
+}
+
+func TestTable(t *testing.T) {
+	table := &Table{
+		Leads:     map[rune]string{0x1112: "h", 0x110B: ""},
+		Medials:   map[rune]string{0x1161: "a"},
+		Tails:     map[rune]string{0x11AB: "n"},
+		Separator: "'",
+	}
+	if out := NewWithScheme(table).Romanize("한아 하"); out != "han'a ha" {
+		t.Errorf("unexpected %s", out)
+	}
+}
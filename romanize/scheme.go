@@ -0,0 +1,60 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package romanize
+
+import (
+	"strings"
+
+	"github.com/suapapa/go_hangul/pronounce"
+)
+
+// Syllable holds lead, medial and tail of a Hangul syllable in conjoining
+// jamo, as returned by hangul.Split. Tail is 0 if the syllable has no tail.
+type Syllable = pronounce.Syllable
+
+// Romanization does not reflect tensification; 압구정 is Apgujeong.
+var soundChanges = pronounce.New(pronounce.AllRules &^ pronounce.Tensification)
+
+// ApplySoundChanges returns a copy of word which tails and leads are
+// rewritten as pronounced. Tails in the result are one of the 7
+// representative final sounds.
+func ApplySoundChanges(word []Syllable) []Syllable {
+	word, _ = soundChanges.Apply(word)
+	return word
+}
+
+// Scheme is a romanization system
+type Scheme interface {
+	// RomanizeWord returns romanization of a word, a run of Hangul
+	// syllables.
+	RomanizeWord(word []Syllable) string
+}
+
+// Table is a Scheme which maps each jamo to Latin letters by tables.
+type Table struct {
+	Leads, Medials, Tails map[rune]string
+	// Pronounced makes sound changes applied before mapping
+	Pronounced bool
+	// Separator is put between a tail and following silent lead, ㅇ
+	Separator string
+}
+
+// RomanizeWord implements Scheme
+func (t *Table) RomanizeWord(word []Syllable) string {
+	if t.Pronounced {
+		word = ApplySoundChanges(word)
+	}
+
+	var b strings.Builder
+	for i, s := range word {
+		if i > 0 && word[i-1].Tail != 0 && t.Leads[s.Lead] == "" {
+			b.WriteString(t.Separator)
+		}
+		b.WriteString(t.Leads[s.Lead])
+		b.WriteString(t.Medials[s.Medial])
+		b.WriteString(t.Tails[s.Tail])
+	}
+	return b.String()
+}
@@ -0,0 +1,118 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package romanize
+
+import (
+	"strings"
+
+	hangul "github.com/suapapa/go_hangul"
+)
+
+type yale struct{}
+
+// Yale is the scheme of Yale romanization which is used in linguistics.
+// It maps jamo one-to-one without sound changes; 한국 is hankwuk.
+var Yale Scheme = yale{}
+
+var yaleLeads = map[rune]string{
+	hangul.LeadG:  "k",
+	hangul.LeadGG: "kk",
+	hangul.LeadN:  "n",
+	hangul.LeadD:  "t",
+	hangul.LeadDD: "tt",
+	hangul.LeadR:  "l",
+	hangul.LeadM:  "m",
+	hangul.LeadB:  "p",
+	hangul.LeadBB: "pp",
+	hangul.LeadS:  "s",
+	hangul.LeadSS: "ss",
+	hangul.LeadZS: "",
+	hangul.LeadJ:  "c",
+	hangul.LeadJJ: "cc",
+	hangul.LeadC:  "ch",
+	hangul.LeadK:  "kh",
+	hangul.LeadT:  "th",
+	hangul.LeadP:  "ph",
+	hangul.LeadH:  "h",
+}
+
+var yaleMedials = map[rune]string{
+	hangul.MedialA:   "a",
+	hangul.MedialAE:  "ay",
+	hangul.MedialYA:  "ya",
+	hangul.MedialYAE: "yay",
+	hangul.MedialEO:  "e",
+	hangul.MedialE:   "ey",
+	hangul.MedialYEO: "ye",
+	hangul.MedialYE:  "yey",
+	hangul.MedialO:   "o",
+	hangul.MedialWA:  "wa",
+	hangul.MedialWAE: "way",
+	hangul.MedialOE:  "oy",
+	hangul.MedialYO:  "yo",
+	hangul.MedialU:   "wu",
+	hangul.MedialWEO: "we",
+	hangul.MedialWE:  "wey",
+	hangul.MedialWI:  "wi",
+	hangul.MedialYU:  "yu",
+	hangul.MedialEU:  "u",
+	hangul.MedialYI:  "uy",
+	hangul.MedialI:   "i",
+}
+
+var yaleTails = map[rune]string{
+	hangul.TailG:  "k",
+	hangul.TailGG: "kk",
+	hangul.TailGS: "ks",
+	hangul.TailN:  "n",
+	hangul.TailNJ: "nc",
+	hangul.TailNH: "nh",
+	hangul.TailD:  "t",
+	hangul.TailL:  "l",
+	hangul.TailLG: "lk",
+	hangul.TailLM: "lm",
+	hangul.TailLB: "lp",
+	hangul.TailLS: "ls",
+	hangul.TailLT: "lth",
+	hangul.TailLP: "lph",
+	hangul.TailLH: "lh",
+	hangul.TailM:  "m",
+	hangul.TailB:  "p",
+	hangul.TailBS: "ps",
+	hangul.TailS:  "s",
+	hangul.TailSS: "ss",
+	hangul.TailNG: "ng",
+	hangul.TailJ:  "c",
+	hangul.TailC:  "ch",
+	hangul.TailK:  "kh",
+	hangul.TailT:  "th",
+	hangul.TailP:  "ph",
+	hangul.TailH:  "h",
+}
+
+var labials = map[rune]bool{
+	hangul.LeadM:  true,
+	hangul.LeadB:  true,
+	hangul.LeadBB: true,
+	hangul.LeadP:  true,
+}
+
+func (yale) RomanizeWord(word []Syllable) string {
+	var b strings.Builder
+	for i, s := range word {
+		if i > 0 && word[i-1].Tail != 0 && s.Lead == hangul.LeadZS {
+			b.WriteString(".") // 안아 is an.a, not ana of 아나
+		}
+		b.WriteString(yaleLeads[s.Lead])
+		if s.Medial == hangul.MedialU && labials[s.Lead] {
+			// ㅜ and ㅡ are not distinguished after labials
+			b.WriteString("u")
+		} else {
+			b.WriteString(yaleMedials[s.Medial])
+		}
+		b.WriteString(yaleTails[s.Tail])
+	}
+	return b.String()
+}
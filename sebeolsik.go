@@ -0,0 +1,190 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import "sync"
+
+// SebeolsikLayout maps keys to conjoining jamo; Lead*, Medial* and Tail*.
+// Keys mapped to other runes, digits or symbols, are committed as is.
+type SebeolsikLayout map[rune]rune
+
+// Names of built-in Sebeolsik layouts
+const (
+	Sebeolsik390   = "390"   // 세벌식 390
+	SebeolsikFinal = "final" // 세벌식 최종
+)
+
+var sebeolsik390 = SebeolsikLayout{
+	'\'': LeadT, '/': MedialO, '0': LeadK, '1': TailH, '2': TailSS,
+	'3': TailB, '4': MedialYO, '5': MedialYU, '6': MedialYA, '7': MedialYE,
+	'8': MedialYI, '9': MedialU, ';': LeadB,
+	'a': TailNG, 'b': MedialU, 'c': MedialE, 'd': MedialI, 'e': MedialYEO,
+	'f': MedialA, 'g': MedialEU, 'h': LeadN, 'i': LeadM, 'j': LeadZS,
+	'k': LeadG, 'l': LeadJ, 'm': LeadH, 'n': LeadS, 'o': LeadC,
+	'p': LeadP, 'q': TailS, 'r': MedialAE, 's': TailN, 't': MedialEO,
+	'u': LeadD, 'v': MedialO, 'w': TailL, 'x': TailG, 'y': LeadR,
+	'z': TailM,
+	'!': TailJ, 'A': TailD, 'C': TailLM, 'D': TailLG, 'E': TailK,
+	'F': TailGG, 'Q': TailP, 'R': MedialYAE, 'S': TailNH, 'T': TailLS,
+	'V': TailLH, 'W': TailT, 'X': TailBS, 'Z': TailC,
+	'B': '!', 'G': '/', 'H': '\'', 'I': '8', 'J': '4', 'K': '5', 'L': '6',
+	'M': '1', 'N': '0', 'O': '9', 'P': '>', 'U': '7', 'Y': '<',
+	'<': '2', '>': '3',
+}
+
+var sebeolsikFinal = SebeolsikLayout{
+	'\'': LeadT, '/': MedialO, '0': LeadK, '1': TailH, '2': TailSS,
+	'3': TailB, '4': MedialYO, '5': MedialYU, '6': MedialYA, '7': MedialYE,
+	'8': MedialYI, '9': MedialU, ';': LeadB,
+	'a': TailNG, 'b': MedialU, 'c': MedialE, 'd': MedialI, 'e': MedialYEO,
+	'f': MedialA, 'g': MedialEU, 'h': LeadN, 'i': LeadM, 'j': LeadZS,
+	'k': LeadG, 'l': LeadJ, 'm': LeadH, 'n': LeadS, 'o': LeadC,
+	'p': LeadP, 'q': TailS, 'r': MedialAE, 's': TailN, 't': MedialEO,
+	'u': LeadD, 'v': MedialO, 'w': TailL, 'x': TailG, 'y': LeadR,
+	'z': TailM,
+	'!': TailGG, '@': TailLG, '#': TailJ, '$': TailLP, '%': TailLT,
+	'A': TailD, 'C': TailK, 'D': TailLB, 'E': TailNJ, 'F': TailLM,
+	'G': MedialYAE, 'Q': TailP, 'R': TailLH, 'S': TailNH, 'T': TailLS,
+	'V': TailGS, 'W': TailT, 'X': TailBS, 'Z': TailC,
+	'H': '0', 'J': '1', 'K': '2', 'L': '3', ':': '4',
+	'Y': '5', 'U': '6', 'I': '7', 'O': '8', 'P': '9',
+}
+
+var (
+	sebeolsikLayoutsMu sync.RWMutex
+	sebeolsikLayouts   = map[string]SebeolsikLayout{
+		Sebeolsik390:   sebeolsik390,
+		SebeolsikFinal: sebeolsikFinal,
+	}
+)
+
+// RegisterSebeolsikLayout registers a Sebeolsik layout with given name.
+// A layout already registered with the name is replaced.
+func RegisterSebeolsikLayout(name string, layout SebeolsikLayout) {
+	sebeolsikLayoutsMu.Lock()
+	defer sebeolsikLayoutsMu.Unlock()
+	sebeolsikLayouts[name] = layout
+}
+
+// Multi-element jamo which can be composed by typing two keys in
+// Sebeolsik.
+var (
+	sebeolsikLeadCompounds   = []rune{GG, DD, BB, SS, JJ}
+	sebeolsikMedialCompounds = []rune{WA, WAE, OE, WEO, WE, WI, YI}
+	sebeolsikTailCompounds   = []rune{
+		GG, GS, NJ, NH, LG, LM, LB, LS, LT, LP, LH, BS, SS,
+	}
+)
+
+// Sebeolsik is a Hangul input automaton for Sebeolsik (3-set)
+// keyboard layouts.
+type Sebeolsik struct {
+	automaton
+	layout   SebeolsikLayout
+	moachigi bool
+}
+
+// NewSebeolsik creates a new Sebeolsik input automaton with the layout
+// registered with given name. If moachigi is true, lead, medial and tail
+// of a syllable can be typed in any order.
+func NewSebeolsik(layout string, moachigi bool) (*Sebeolsik, error) {
+	sebeolsikLayoutsMu.RLock()
+	l, ok := sebeolsikLayouts[layout]
+	sebeolsikLayoutsMu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownLayout
+	}
+
+	return &Sebeolsik{layout: l, moachigi: moachigi}, nil
+}
+
+// Process takes a key and updates preedit and committed text. For a key
+// which is not mapped to a jamo, preedit is committed followed by the
+// mapped rune, or the key itself, and Process returns false.
+func (s *Sebeolsik) Process(key rune) bool {
+	j, ok := s.layout[key]
+	if !ok {
+		j = key
+	}
+
+	switch {
+	case IsLead(j):
+		s.processLead(j)
+	case IsMedial(j):
+		s.processMedial(j)
+	case IsTail(j):
+		s.processTail(j)
+	default:
+		s.commitWith(j)
+		return false
+	}
+	return true
+}
+
+// prev returns preedit before the last key. Jamo are combined only with
+// the one typed just before; ㄱ and ㄱ to ㄲ, but not ㄱ, ㅏ and ㄱ.
+func (s *Sebeolsik) prev() syllableState {
+	if len(s.history) == 0 {
+		return syllableState{}
+	}
+	return s.history[len(s.history)-1]
+}
+
+func (s *Sebeolsik) processLead(j rune) {
+	st := s.cur
+	switch {
+	case st.l == 0 && (st.empty() || s.moachigi):
+		st.l = j
+	case st.l != 0 && st.l != s.prev().l:
+		c := combineJamo(st.l, j, sebeolsikLeadCompounds)
+		if c == 0 {
+			s.startWith(syllableState{l: j})
+			return
+		}
+		st.l = Lead(c)
+	default:
+		s.startWith(syllableState{l: j})
+		return
+	}
+	s.push(st)
+}
+
+func (s *Sebeolsik) processMedial(j rune) {
+	st := s.cur
+	switch {
+	case st.m == 0 && (st.t == 0 || s.moachigi):
+		st.m = j
+	case st.m != 0 && st.m != s.prev().m:
+		c := combineJamo(st.m, j, sebeolsikMedialCompounds)
+		if c == 0 {
+			s.startWith(syllableState{m: j})
+			return
+		}
+		st.m = Medial(c)
+	default:
+		s.startWith(syllableState{m: j})
+		return
+	}
+	s.push(st)
+}
+
+func (s *Sebeolsik) processTail(j rune) {
+	st := s.cur
+	switch {
+	case st.t == 0 && (st.l != 0 && st.m != 0 || s.moachigi):
+		st.t = j
+	case st.t != 0 && st.t != s.prev().t:
+		c := combineJamo(st.t, j, sebeolsikTailCompounds)
+		if c == 0 {
+			s.startWith(syllableState{t: j})
+			return
+		}
+		st.t = Tail(c)
+	default:
+		s.startWith(syllableState{t: j})
+		return
+	}
+	s.push(st)
+}
@@ -32,22 +32,17 @@ var strokes = map[rune]int{
 }
 
 // Stroke returns stroke count of given jamo.
-func Stroke(r rune) (c int) {
-	if 0xAC00 <= r && r <= 0xD7A3 {
-		i, m, f := Split(r)
-		c += Stroke(i)
-		c += Stroke(m)
-		c += Stroke(f)
-		return
+func Stroke(r rune) int {
+	if isSyllable(r) {
+		l, m, t := Split(r)
+		return jamoStroke(l) + jamoStroke(m) + jamoStroke(t)
 	}
+	return jamoStroke(r)
+}
 
-	jm := CompatJamo(r)
-	if es, ok := SplitMultiElement(jm); ok {
-		for _, e := range es {
-			c += strokes[e]
-		}
-	} else {
-		c = strokes[jm]
+func jamoStroke(r rune) int {
+	if r = CompatJamo(r); r == 0 {
+		return 0
 	}
-	return
+	return compatTable[r-compatBase].stroke
 }
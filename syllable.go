@@ -0,0 +1,222 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import "unicode/utf8"
+
+// Jamo is a Hangul letter in any form; conjoining, compatibility or
+// halfwidth. Use Compat, AsLead, AsMedial and AsTail to convert between
+// the forms.
+type Jamo rune
+
+// Compat returns j in compatibility jamo. It returns 0 if j is not a jamo
+// or has no compatibility form. See CompatJamo.
+func (j Jamo) Compat() Jamo {
+	return Jamo(CompatJamo(rune(j)))
+}
+
+// AsLead returns j in lead consonant. Conjoining jamo of other position
+// is converted as well; ᆨ to ᄀ. It returns 0 if j can not be a lead.
+func (j Jamo) AsLead() Jamo {
+	if r := Lead(rune(j)); r != 0 {
+		return Jamo(r)
+	}
+	return Jamo(Lead(CompatJamo(rune(j))))
+}
+
+// AsMedial returns j in medial vowel. It returns 0 if j can not be a
+// medial.
+func (j Jamo) AsMedial() Jamo {
+	if r := Medial(rune(j)); r != 0 {
+		return Jamo(r)
+	}
+	return Jamo(Medial(CompatJamo(rune(j))))
+}
+
+// AsTail returns j in tail consonant. Conjoining jamo of other position
+// is converted as well; ᄀ to ᆨ. It returns 0 if j can not be a tail.
+func (j Jamo) AsTail() Jamo {
+	if r := Tail(rune(j)); r != 0 {
+		return Jamo(r)
+	}
+	return Jamo(Tail(CompatJamo(rune(j))))
+}
+
+// IsConsonant checks j is a consonant(자음). See IsJaeum.
+func (j Jamo) IsConsonant() bool {
+	return IsJaeum(rune(j))
+}
+
+// IsVowel checks j is a vowel(모음). See IsMoeum.
+func (j Jamo) IsVowel() bool {
+	return IsMoeum(rune(j))
+}
+
+// Components returns elements of j in compatibility jamo; ㄳ to ㄱ, ㅅ and
+// ㅘ to ㅗ, ㅏ. It returns j itself in compatibility jamo if it is a single
+// letter, and nil if j is not a jamo. See SplitMultiElement.
+func (j Jamo) Components() []Jamo {
+	c := j.Compat()
+	if c == 0 {
+		return nil
+	}
+	es, ok := SplitMultiElement(rune(c))
+	if !ok {
+		return []Jamo{c}
+	}
+	js := make([]Jamo, len(es))
+	for i, e := range es {
+		js[i] = Jamo(e)
+	}
+	return js
+}
+
+// IsDouble checks j is made of two same letters; ㄲ, ㄸ, ㅃ, ㅆ, ㅉ and
+// archaic ones like ㆀ.
+func (j Jamo) IsDouble() bool {
+	es, ok := SplitMultiElement(rune(j))
+	return ok && len(es) == 2 && es[0] == es[1]
+}
+
+// IsTense checks j is a tense consonant(된소리); ㄲ, ㄸ, ㅃ, ㅆ, ㅉ.
+// See ConsonantFeatures.
+func (j Jamo) IsTense() bool {
+	f, ok := ConsonantFeatures(rune(j))
+	return ok && f.Phonation == Tense
+}
+
+// IsAspirated checks j is an aspirated consonant(거센소리); ㅊ, ㅋ, ㅌ, ㅍ.
+// See ConsonantFeatures.
+func (j Jamo) IsAspirated() bool {
+	f, ok := ConsonantFeatures(rune(j))
+	return ok && f.Phonation == Aspirated
+}
+
+// Strokes returns stroke count of j. See Stroke.
+func (j Jamo) Strokes() int {
+	return Stroke(rune(j))
+}
+
+// String returns j in its own form.
+func (j Jamo) String() string {
+	if j == 0 {
+		return ""
+	}
+	return string(rune(j))
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (j Jamo) MarshalText() ([]byte, error) {
+	return []byte(j.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Text should be a
+// jamo, or empty for 0.
+func (j *Jamo) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*j = 0
+		return nil
+	}
+	r, n := utf8.DecodeRune(text)
+	if n != len(text) ||
+		CompatJamo(r) == 0 && Lead(r) == 0 && Medial(r) == 0 && Tail(r) == 0 {
+		return ErrNotJamo
+	}
+	*j = Jamo(r)
+	return nil
+}
+
+// Syllable is a modern Hangul syllable. Lead, Medial and Tail are in
+// conjoining jamo, and Tail is 0 if the syllable has no tail. Use
+// NewSyllable or SyllableOf to make a valid one.
+type Syllable struct {
+	Lead, Medial, Tail Jamo
+}
+
+// NewSyllable returns Syllable of given jamo. Jamo can be in any form.
+// It returns an error if they can not make a syllable. See JoinE.
+func NewSyllable(l, m, t Jamo) (Syllable, error) {
+	r, err := JoinE(rune(l), rune(m), rune(t))
+	if err != nil {
+		return Syllable{}, err
+	}
+	return SyllableOf(r)
+}
+
+// SyllableOf returns Syllable of precomposed syllable r. It returns
+// ErrNotSyllable if r is not a Hangul syllable. See SplitE.
+func SyllableOf(r rune) (Syllable, error) {
+	l, m, t, err := SplitE(r)
+	if err != nil {
+		return Syllable{}, err
+	}
+	return Syllable{Jamo(l), Jamo(m), Jamo(t)}, nil
+}
+
+// LeadIndex returns index of the lead; 0 for ㄱ to 18 for ㅎ.
+func (s Syllable) LeadIndex() int {
+	i, _ := leadIdx(rune(s.Lead))
+	return i
+}
+
+// MedialIndex returns index of the medial; 0 for ㅏ to 20 for ㅣ.
+func (s Syllable) MedialIndex() int {
+	i, _ := medialIdx(rune(s.Medial))
+	return i
+}
+
+// TailIndex returns index of the tail; 0 for no tail, 1 for ㄱ to 27
+// for ㅎ.
+func (s Syllable) TailIndex() int {
+	i, _ := tailIdx(rune(s.Tail))
+	return i
+}
+
+// Rune returns s as precomposed syllable. It returns U+FFFD if s is not
+// valid. See Join.
+func (s Syllable) Rune() rune {
+	return Join(rune(s.Lead), rune(s.Medial), rune(s.Tail))
+}
+
+// String returns s as precomposed syllable, or empty string for the zero
+// value as MarshalText does.
+func (s Syllable) String() string {
+	if s == (Syllable{}) {
+		return ""
+	}
+	return string(s.Rune())
+}
+
+// MarshalText implements encoding.TextMarshaler. Syllables are marshaled
+// in precomposed form; "한". The zero value is marshaled as empty text.
+func (s Syllable) MarshalText() ([]byte, error) {
+	if s == (Syllable{}) {
+		return []byte{}, nil
+	}
+	r, err := JoinE(rune(s.Lead), rune(s.Medial), rune(s.Tail))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(string(r)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Text should be a
+// precomposed syllable, or empty for the zero value.
+func (s *Syllable) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*s = Syllable{}
+		return nil
+	}
+	r, n := utf8.DecodeRune(text)
+	if n != len(text) {
+		return ErrNotSyllable
+	}
+	v, err := SyllableOf(r)
+	if err != nil {
+		return err
+	}
+	*s = v
+	return nil
+}
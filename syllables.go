@@ -0,0 +1,103 @@
+// Copyright 2012, Homin Lee <homin.lee@suapapa.net>. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hangul
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// isExtend checks given rune extends the preceding character;
+// combining marks including tone marks(방점) of Middle Korean, and ZWJ.
+func isExtend(r rune) bool {
+	return r == 0x200D || unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+// blockLen returns byte length of the first user-perceived character of
+// s, following the grapheme cluster boundary rules of UAX #29 for Hangul;
+// L x (L | V | LV | LVT), (LV | V) x (V | T), (LVT | T) x T.
+func blockLen(s string) int {
+	r, n := utf8.DecodeRuneInString(s)
+	if r == '\r' && len(s) > 1 && s[1] == '\n' {
+		return 2
+	}
+
+	prev := syllableType(r)
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if isExtend(r) {
+			n += size
+			continue
+		}
+		t := syllableType(r)
+		if !joinable(prev, t) {
+			break
+		}
+		prev = t
+		n += size
+	}
+	return n
+}
+
+// SyllableIterator iterates user-perceived syllables of a string.
+// See Syllables.
+type SyllableIterator struct {
+	s          string
+	start, end int
+}
+
+// Syllables returns an iterator over user-perceived syllables of s. A
+// precomposed syllable and a sequence of conjoining jamo, like L+ V+ T*,
+// are one syllable each, with following combining marks. Other characters
+// are iterated one by one.
+//
+//	for it := hangul.Syllables(s); it.Next(); {
+//		fmt.Println(it.Offset(), it.Text())
+//	}
+func Syllables(s string) *SyllableIterator {
+	return &SyllableIterator{s: s}
+}
+
+// Next advances the iterator to the next syllable. It returns false when
+// there is no more syllable.
+func (it *SyllableIterator) Next() bool {
+	if it.end >= len(it.s) {
+		it.start = it.end
+		return false
+	}
+	it.start = it.end
+	it.end += blockLen(it.s[it.start:])
+	return true
+}
+
+// Text returns the current syllable.
+func (it *SyllableIterator) Text() string {
+	return it.s[it.start:it.end]
+}
+
+// Offset returns byte offset of the current syllable in the string.
+func (it *SyllableIterator) Offset() int {
+	return it.start
+}
+
+// CountSyllables returns number of user-perceived syllables in s.
+// See Syllables.
+func CountSyllables(s string) int {
+	n := 0
+	for i := 0; i < len(s); n++ {
+		i += blockLen(s[i:])
+	}
+	return n
+}
+
+// Truncate returns the first n user-perceived syllables of s. It never
+// cuts a syllable of conjoining jamo in half. See Syllables.
+func Truncate(s string, n int) string {
+	i := 0
+	for ; n > 0 && i < len(s); n-- {
+		i += blockLen(s[i:])
+	}
+	return s[:i]
+}